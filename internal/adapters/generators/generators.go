@@ -3,6 +3,8 @@ package adapters
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/rapidstellar/gohexa/internal/core/domain"
 	"github.com/rapidstellar/gohexa/internal/core/services"
@@ -27,18 +29,57 @@ func (g *GenratorAdapter) GohexaGeneratorAdapter(gf domain.GeneratorFlag) {
 	outputDir := gf.OutputDir
 	templateName := gf.TemplateName
 	useUUID := gf.UseUUID
+	pureDomain := gf.PureDomain
 	help := gf.Help
 
-	srv := services.NewGeneratorService(domain.GeneratorFlagDomain{
-		FeatureName: *featureName,
-		ProjectName: *projectName,
-	})
-
 	if *help {
 		showHelp()
 		return
 	}
 
+	fields, err := services.ParseFields(*gf.Fields)
+	if err != nil {
+		fmt.Printf("Invalid -fields: %v\n", err)
+		return
+	}
+	if !slices.Contains(domain.HTTPFrameworks, *gf.HTTP) {
+		fmt.Printf("Invalid -http %q. Options are: %s.\n", *gf.HTTP, strings.Join(domain.HTTPFrameworks, ", "))
+		return
+	}
+	if !slices.Contains(domain.RPCFrameworks, *gf.RPC) {
+		fmt.Printf("Invalid -rpc %q. Options are: %s.\n", *gf.RPC, strings.Join(domain.RPCFrameworks, ", "))
+		return
+	}
+	orm, db, err := resolvePersistence(*gf.ORM, *gf.DB)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if !slices.Contains(domain.MigrationFormats, *gf.Migration) {
+		fmt.Printf("Invalid -migration %q. Options are: %s.\n", *gf.Migration, strings.Join(domain.MigrationFormats, ", "))
+		return
+	}
+	if db == "mongo" && !*useUUID && !*pureDomain {
+		fmt.Println("With -db mongo the model keeps its ObjectID, which only -pure maps to the string ID of the domain.")
+		fmt.Println("Add -pure, or -uuid for string IDs.")
+		return
+	}
+
+	srv := services.NewGeneratorService(domain.GeneratorFlagDomain{
+		FeatureName: *featureName,
+		ProjectName: *projectName,
+		UseUUID:     *useUUID,
+		PureDomain:  *pureDomain,
+		Fields:      fields,
+		HTTP:        *gf.HTTP,
+		RPC:         *gf.RPC,
+		ORM:         orm,
+		DB:          db,
+		ORMSet:      *gf.ORM != "",
+		DBSet:       *gf.DB != "",
+		Migration:   *gf.Migration,
+	})
+
 	if *generateType == "" {
 		fmt.Println("Please specify a generate type using the -generate flag.")
 		return
@@ -151,15 +192,99 @@ func (g *GenratorAdapter) GohexaGeneratorAdapter(gf domain.GeneratorFlag) {
 			return
 		}
 		srv.GenerateAppFile(*outputDir)
+	case "grpc":
+		if *featureName == "" {
+			fmt.Println("Please provide a feature name using -feature flags.")
+			return
+		}
+		if *outputDir == "" {
+			*outputDir = "."
+		}
+		srv.GenerateGRPCFiles(*outputDir)
+	case "graphql":
+		if *featureName == "" {
+			fmt.Println("Please provide a feature name using -feature flags.")
+			return
+		}
+		if *outputDir == "" {
+			*outputDir = "."
+		}
+		srv.GenerateGraphQLFiles(*outputDir)
+	case "migration":
+		if *featureName == "" {
+			fmt.Println("Please provide a feature name using -feature flags.")
+			return
+		}
+		if *outputDir == "" {
+			*outputDir = "migrations"
+		}
+		srv.GenerateMigrationFiles(*outputDir)
+	case "verify":
+		if *featureName == "" {
+			fmt.Println("Please provide a feature name using -feature flags.")
+			return
+		}
+		issues, err := srv.VerifyFeature()
+		if err != nil {
+			fmt.Printf("Error verifying feature: %v\n", err)
+			os.Exit(1)
+		}
+		for _, issue := range issues {
+			fmt.Printf("%s:%d:%d: %s\n", issue.File, issue.Line, issue.Column, issue.Message)
+		}
+		if len(issues) > 0 {
+			fmt.Printf("%d type error(s) in the generated code of '%s'.\n", len(issues), *featureName)
+			os.Exit(1)
+		}
+		fmt.Printf("Generated code of '%s' type-checks.\n", *featureName)
 	default:
-		fmt.Println("Invalid generate type. Options are: project, transactor, model, domain, port, repository, service, handler, route, app.")
+		fmt.Println("Invalid generate type. Options are: project, transactor, model, domain, port, repository, service, handler, route, app, grpc, graphql, migration, verify.")
 	}
 
 }
 
+// resolvePersistence validates -orm and -db, defaulting the empty ones to the project settings.
+func resolvePersistence(orm, db string) (string, string, error) {
+	if orm == "" {
+		orm = services.ProjectORM(services.ProjectRoot())
+	}
+	if !slices.Contains(domain.ORMs, orm) {
+		return "", "", fmt.Errorf("Invalid -orm %q. Options are: %s.", orm, strings.Join(domain.ORMs, ", "))
+	}
+	db = services.NormalizeDatabase(db)
+	if db == "" {
+		db = services.ProjectDatabase(services.ProjectRoot())
+	}
+	if !slices.Contains(domain.Databases, db) {
+		return "", "", fmt.Errorf("Invalid -db %q. Options are: %s.", db, strings.Join(domain.Databases, ", "))
+	}
+	if (orm == "pgx" || orm == "sqlc") && db != "mongo" && !slices.Contains(domain.PostgresCompatible, db) {
+		return "", "", fmt.Errorf("-orm %s only supports %s, not -db %s.", orm, strings.Join(domain.PostgresCompatible, ", "), db)
+	}
+	return orm, db, nil
+}
+
 // showHelp displays the help message for the command-line tool
 func showHelp() {
 	fmt.Println("Usage: gohexa [options]")
+	fmt.Println("       gohexa <command> [options]")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list               Lists the features of a project and which layers exist, are missing or are outdated.")
+	fmt.Println("                      -dir string   The project root to scan. Default is '.'.")
+	fmt.Println("  lint               Reports imports that break the hexagonal dependency rules and exits non-zero.")
+	fmt.Println("                      -dir string   The project root to lint. Default is '.'.")
+	fmt.Println("  doctor             Checks module paths, AppContainer, port implementations, route registrations and ID types.")
+	fmt.Println("                      -dir string   The project root to diagnose. Default is '.'.")
+	fmt.Println("  import <source>    Writes the feature specs of an existing schema, or generates their layers with -generate.")
+	fmt.Println("                      db -dsn string           The tables of a live database (sqlite, postgres or mysql DSN).")
+	fmt.Println("                      ddl <file>               The CREATE TABLE statements of a SQL file.")
+	fmt.Println("                      openapi <file>           The resources of an OpenAPI 3 document.")
+	fmt.Println("                      struct <file>:<struct>   A Go struct; -generate adds only the missing layers.")
+	fmt.Println("                      -tables string           Comma separated tables or resources to import. Default is all.")
+	fmt.Println("                      -output string           The file the feature specs are written to. Default is stdout.")
+	fmt.Println("                      -generate                Generate the layers of each feature, with -project, -pure, -http,")
+	fmt.Println("                                               -orm, -db and -migration as for the options below.")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  -generate string   Type of code to generate. Options include:")
@@ -173,6 +298,16 @@ func showHelp() {
 	fmt.Println("                      handler        - Generates a handler file. Requires -feature flag.")
 	fmt.Println("                      route          - Generates a route file. Requires -feature flag.")
 	fmt.Println("                      app            - Generates an app file. Requires -feature and -output flags.")
+	fmt.Println("                      grpc           - Generates the .proto contract, gRPC server adapter and its registration")
+	fmt.Println("                                       below the project root given with -output (default '.'). Requires -feature flag.")
+	fmt.Println("                      graphql        - Generates a gqlgen schema fragment, its resolvers and, when missing, gqlgen.yml")
+	fmt.Println("                                       below the project root given with -output (default '.'). Requires -feature flag.")
+	fmt.Println("                      migration      - Generates timestamped SQL migrations creating the table of a feature,")
+	fmt.Println("                                       with its indexes and foreign keys, in the -output directory")
+	fmt.Println("                                       (default 'migrations'), or altering it from the fields of the")
+	fmt.Println("                                       last migration recorded in gohexa.json. Requires -feature flag.")
+	fmt.Println("                      verify         - Type-checks all layers of a feature offline, without writing files.")
+	fmt.Println("                                       Requires -feature flag.")
 	fmt.Println()
 	fmt.Println("  -project string    The name of the project to generate. Default is 'my_project'.")
 	fmt.Println("  -feature string    The name of the feature for which to generate files. Required for:")
@@ -187,6 +322,41 @@ func showHelp() {
 	fmt.Println()
 	fmt.Println("  -uuid              Use UUID for ID fields instead of uint. Default is false.")
 	fmt.Println()
+	fmt.Println("  -fields string     Comma separated fields of the feature as name:type, used by model, domain and repository.")
+	fmt.Println("                    Types: string, int, int64, uint, float64, bool, time and their SQL names such as text,")
+	fmt.Println("                    bigint, decimal, boolean or timestamp. Default is 'field_1:string,field_2:string'.")
+	fmt.Println("                    The type may be followed by index, unique, ref=<table> or was=<column> (renamed from)")
+	fmt.Println("                    for the migrations, e.g.")
+	fmt.Println("                    'customer_id:uint:index:ref=customers,email:string:unique'.")
+	fmt.Println()
+	fmt.Println("  -pure              Generate a persistence-agnostic core: domain structs without ORM tags or imports,")
+	fmt.Println("                    ports and services that only use domain types, and the model mappers in the")
+	fmt.Println("                    repository adapter. Applies to domain, port, repository and service. Default is false.")
+	fmt.Println()
+	fmt.Println("  -http string       HTTP framework of the handler, route and app files: fiber, gin, echo,")
+	fmt.Println("                    stdlib (net/http) or chi. Default is 'fiber'.")
+	fmt.Println("                    Other than fiber, the route generator also writes the routers' router.go if it is missing.")
+	fmt.Println()
+	fmt.Println("  -rpc string        RPC framework of -generate grpc: grpc or connect (connect-go handlers mounted on a")
+	fmt.Println("                    net/http mux). Default is 'grpc'.")
+	fmt.Println()
+	fmt.Println("  -orm string        Persistence library of the model, repository, transactor and app files: gorm,")
+	fmt.Println("                    sqlx (explicit SQL over *sqlx.DB), pgx (pgxpool, with batch and COPY bulk inserts) or")
+	fmt.Println("                    sqlc (the repository generator also writes the queries, the schema and sqlc.yaml),")
+	fmt.Println("                    ent (the repository generator also writes the ent schema) or bun.")
+	fmt.Println("                    Default is the 'orm' key of gohexa.json, else the ORM environment variable, else")
+	fmt.Println("                    DB_ADAPTER when it names a library, else 'gorm'.")
+	fmt.Println()
+	fmt.Println("  -db string         Database of the model, repository, transactor and app files: postgres, cockroachdb,")
+	fmt.Println("                    mysql, sqlite or mongo. The SQL databases set the UUID default and the column types;")
+	fmt.Println("                    pgx and sqlc need postgres or cockroachdb. mongo generates bson documents and a")
+	fmt.Println("                    *mongo.Collection repository whatever -orm; the ID is an ObjectID, which needs -pure,")
+	fmt.Println("                    or a UUID string with -uuid. Default is the 'database' key of gohexa.json, else the")
+	fmt.Println("                    DATABASE environment variable, else mongo if DB_ADAPTER is mongo, else 'postgres'.")
+	fmt.Println()
+	fmt.Println("  -migration string  Format of -generate migration: golang-migrate (up and down files) or goose (one file")
+	fmt.Println("                    with both). Default is 'golang-migrate'.")
+	fmt.Println()
 	fmt.Println("  -help              Show this help message and exit.")
 	fmt.Println()
 	fmt.Println("Examples:")
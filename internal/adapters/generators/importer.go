@@ -0,0 +1,221 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/adapters/introspectors"
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/internal/core/services"
+)
+
+type IImportAdapter interface {
+	GohexaImportDBAdapter(flag domain.ImportFlag) error
+	GohexaImportDDLAdapter(path string, flag domain.ImportFlag) error
+	GohexaImportOpenAPIAdapter(path string, flag domain.ImportFlag) error
+	GohexaImportStructAdapter(path string, flag domain.ImportFlag) error
+}
+
+type ImportAdapter struct{}
+
+func NewImportAdapter() IImportAdapter {
+	return &ImportAdapter{}
+}
+
+// GohexaImportDBAdapter implements IImportAdapter.
+func (a *ImportAdapter) GohexaImportDBAdapter(f domain.ImportFlag) error {
+	if *f.DSN == "" {
+		return fmt.Errorf("please provide the database with -dsn, e.g. -dsn sqlite://app.db")
+	}
+	introspector, err := introspectors.NewIntrospector(*f.DSN)
+	if err != nil {
+		return err
+	}
+	defer introspector.Close()
+	tables, err := introspector.Tables(context.Background())
+	if err != nil {
+		return fmt.Errorf("error reading the schema: %v", err)
+	}
+	// The tables exist, so the next migration of a feature alters its table.
+	return importFeatures(tables, introspector.Dialect(), f, domain.FeatureLayers, true)
+}
+
+// GohexaImportDDLAdapter implements IImportAdapter.
+func (a *ImportAdapter) GohexaImportDDLAdapter(path string, f domain.ImportFlag) error {
+	if path == "" {
+		return fmt.Errorf("please provide the DDL file, e.g. gohexa import ddl schema.sql")
+	}
+	ddl, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	tables, dialect, err := services.NewImportService().ParseDDL(string(ddl))
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %v", path, err)
+	}
+	// The tables are a design, so their migrations are generated with the other layers.
+	return importFeatures(tables, dialect, f, append(slices.Clone(domain.FeatureLayers), "migration"), false)
+}
+
+// GohexaImportOpenAPIAdapter implements IImportAdapter.
+func (a *ImportAdapter) GohexaImportOpenAPIAdapter(path string, f domain.ImportFlag) error {
+	if path == "" {
+		return fmt.Errorf("please provide the OpenAPI document, e.g. gohexa import openapi api.yaml")
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	specs, warnings, err := services.NewImportService().ParseOpenAPI(content)
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %v", path, err)
+	}
+	if *f.Tables != "" {
+		wanted := strings.Split(*f.Tables, ",")
+		specs = slices.DeleteFunc(specs, func(spec domain.FeatureSpec) bool {
+			return !slices.Contains(wanted, spec.Table)
+		})
+	}
+	// The resources are a design, so their migrations are generated with the other layers.
+	return writeFeatures(specs, warnings, "", f, append(slices.Clone(domain.FeatureLayers), "migration"), false)
+}
+
+// GohexaImportStructAdapter implements IImportAdapter. path is the file and the name of the struct,
+// e.g. ./internal/core/domain/order.go:Order. With -generate, only the layers the project lacks are
+// generated around the struct.
+func (a *ImportAdapter) GohexaImportStructAdapter(path string, f domain.ImportFlag) error {
+	i := strings.LastIndex(path, ":")
+	if i <= 0 || i == len(path)-1 {
+		return fmt.Errorf("please provide the file and the struct, e.g. gohexa import struct ./internal/core/domain/order.go:Order")
+	}
+	file, typeName := path[:i], path[i+1:]
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	spec, warnings, err := services.NewImportService().ParseStruct(file, src, typeName)
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %v", file, err)
+	}
+	if !*f.Generate {
+		return writeFeatures([]domain.FeatureSpec{spec}, warnings, "", f, nil, false)
+	}
+
+	inventory, err := services.NewInspectorService().ListFeatures(".")
+	if err != nil {
+		return err
+	}
+	var layers []string
+	for _, layer := range domain.FeatureLayers {
+		status := domain.LayerMissing
+		var existing string
+		for _, item := range inventory {
+			if item.Name == spec.Feature {
+				status, existing = item.Layers[layer], item.Files[layer]
+			}
+		}
+		if status == domain.LayerMissing {
+			layers = append(layers, layer)
+			continue
+		}
+		fmt.Printf("Skipping the %s layer of %s: '%s' exists.\n", layer, spec.Feature, existing)
+		dir := strings.ReplaceAll(domain.FeatureLayerDirs[layer], "%s", strings.ToLower(spec.Feature))
+		if layer == "domain" && filepath.ToSlash(filepath.Dir(filepath.Clean(existing))) != dir {
+			warnings = append(warnings, fmt.Sprintf("the generated layers import the domain of %s from %s, not from the package of %s", spec.Feature, dir, existing))
+		}
+	}
+	if len(layers) == 0 {
+		fmt.Printf("All layers of %s exist.\n", spec.Feature)
+		return nil
+	}
+	return writeFeatures([]domain.FeatureSpec{spec}, warnings, "", f, layers, false)
+}
+
+// importFeatures turns the tables selected with -tables into features, and writes them with
+// writeFeatures.
+func importFeatures(tables []domain.SchemaTable, dialect string, f domain.ImportFlag, layers []string, exist bool) error {
+	if *f.Tables != "" {
+		wanted := strings.Split(*f.Tables, ",")
+		tables = slices.DeleteFunc(tables, func(t domain.SchemaTable) bool {
+			return !slices.Contains(wanted, t.Name)
+		})
+	}
+	specs, warnings := services.NewImportService().Features(tables)
+	return writeFeatures(specs, warnings, dialect, f, layers, exist)
+}
+
+// writeFeatures writes the specs of the imported features or, with -generate, their layers.
+// dialect is the database of the generated layers unless -db is given. The schema of the features
+// is recorded when their tables exist.
+func writeFeatures(specs []domain.FeatureSpec, warnings []string, dialect string, f domain.ImportFlag, layers []string, exist bool) error {
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, "Warning:", warning)
+	}
+	if len(specs) == 0 {
+		return fmt.Errorf("no feature to import")
+	}
+
+	if !*f.Generate {
+		content, err := json.MarshalIndent(specs, "", "  ")
+		if err != nil {
+			return err
+		}
+		content = append(content, '\n')
+		if *f.Output == "" {
+			_, err = os.Stdout.Write(content)
+			return err
+		}
+		if err := os.WriteFile(*f.Output, content, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("Feature specs '%s' created successfully!\n", *f.Output)
+		return nil
+	}
+
+	db := *f.DB
+	if db == "" {
+		db = dialect
+	}
+	orm, db, err := resolvePersistence(*f.ORM, db)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(domain.HTTPFrameworks, *f.HTTP) {
+		return fmt.Errorf("Invalid -http %q. Options are: %s.", *f.HTTP, strings.Join(domain.HTTPFrameworks, ", "))
+	}
+	if !slices.Contains(domain.MigrationFormats, *f.Migration) {
+		return fmt.Errorf("Invalid -migration %q. Options are: %s.", *f.Migration, strings.Join(domain.MigrationFormats, ", "))
+	}
+	for _, spec := range specs {
+		fields, err := services.ParseFields(spec.Fields)
+		if err != nil {
+			return fmt.Errorf("feature %s: %v", spec.Feature, err)
+		}
+		srv := services.NewGeneratorService(domain.GeneratorFlagDomain{
+			FeatureName: spec.Feature,
+			ProjectName: *f.ProjectName,
+			UseUUID:     spec.UUID,
+			PureDomain:  *f.PureDomain,
+			Fields:      fields,
+			HTTP:        *f.HTTP,
+			ORM:         orm,
+			DB:          db,
+			ORMSet:      *f.ORM != "",
+			DBSet:       *f.DB != "" || dialect != "",
+			Migration:   *f.Migration,
+			Table:       spec.Table,
+			Path:        spec.Path,
+			Operations:  spec.Operations,
+		})
+		srv.GenerateFeatureLayers(".", layers)
+		if exist {
+			srv.RecordSchema()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/internal/core/services"
+)
+
+type IInspectorAdapter interface {
+	GohexaListAdapter(flag domain.InspectFlag) error
+	GohexaLintAdapter(flag domain.InspectFlag) error
+	GohexaDoctorAdapter(flag domain.InspectFlag) error
+}
+
+type InspectorAdapter struct{}
+
+func NewInspectorAdapter() IInspectorAdapter {
+	return &InspectorAdapter{}
+}
+
+// GohexaListAdapter implements IInspectorAdapter.
+func (a *InspectorAdapter) GohexaListAdapter(f domain.InspectFlag) error {
+	srv := services.NewInspectorService()
+	inventory, err := srv.ListFeatures(*f.Dir)
+	if err != nil {
+		return fmt.Errorf("error scanning project: %v", err)
+	}
+	if len(inventory) == 0 {
+		fmt.Printf("No features found in '%s'.\n", *f.Dir)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "FEATURE\t%s\n", strings.ToUpper(strings.Join(domain.FeatureLayers, "\t")))
+	for _, item := range inventory {
+		cells := make([]string, 0, len(domain.FeatureLayers))
+		for _, layer := range domain.FeatureLayers {
+			cells = append(cells, string(item.Layers[layer]))
+		}
+		fmt.Fprintf(w, "%s\t%s\n", item.Name, strings.Join(cells, "\t"))
+	}
+	return w.Flush()
+}
+
+// GohexaLintAdapter implements IInspectorAdapter.
+// It returns an error when a forbidden import is found, a file does not parse or an import does not match the module,
+// so the command exits non-zero in CI.
+func (a *InspectorAdapter) GohexaLintAdapter(f domain.InspectFlag) error {
+	srv := services.NewInspectorService()
+	issues, err := srv.Lint(*f.Dir)
+	if err != nil {
+		return fmt.Errorf("error linting project: %v", err)
+	}
+	others := 0
+	for _, issue := range issues {
+		if issue.Error != "" {
+			others++
+			fmt.Printf("%s:%d:%d: %s\n", issue.File, issue.Line, issue.Column, issue.Error)
+			continue
+		}
+		fmt.Printf("%s:%d:%d: %s package imports %s package %q\n",
+			issue.File, issue.Line, issue.Column, issue.Layer, issue.ImportLayer, issue.Import)
+	}
+	if others > 0 {
+		return fmt.Errorf("%d forbidden import(s) and %d other problem(s) found", len(issues)-others, others)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%d forbidden import(s) found", len(issues))
+	}
+	fmt.Println("No forbidden imports found.")
+	return nil
+}
+
+// GohexaDoctorAdapter implements IInspectorAdapter.
+func (a *InspectorAdapter) GohexaDoctorAdapter(f domain.InspectFlag) error {
+	srv := services.NewInspectorService()
+	diagnostics, err := srv.Diagnose(*f.Dir)
+	if err != nil {
+		return fmt.Errorf("error diagnosing project: %v", err)
+	}
+	for _, d := range diagnostics {
+		fmt.Printf("%s:%d: [%s] %s\n", d.File, d.Line, d.Check, d.Message)
+		fmt.Printf("    fix: %s\n", d.Fix)
+	}
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%d problem(s) found", len(diagnostics))
+	}
+	fmt.Println("No problems found.")
+	return nil
+}
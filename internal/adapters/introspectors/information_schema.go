@@ -0,0 +1,62 @@
+package introspectors
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// informationSchemaQueries are the queries reading a schema from information_schema, which
+// PostgreSQL and MySQL both have with their own details.
+type informationSchemaQueries struct {
+	columns     string // table, column, type, nullable (YES or NO), default, primary key
+	foreignKeys string // table, column, referenced table, referenced column
+}
+
+// readInformationSchema runs queries on db and returns the tables in the order of the columns query.
+func readInformationSchema(ctx context.Context, db *sql.DB, queries informationSchemaQueries) ([]domain.SchemaTable, error) {
+	rows, err := db.QueryContext(ctx, queries.columns)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []domain.SchemaTable
+	index := map[string]int{}
+	for rows.Next() {
+		var table, nullable string
+		var column domain.SchemaColumn
+		var def sql.NullString
+		if err := rows.Scan(&table, &column.Name, &column.Type, &nullable, &def, &column.PrimaryKey); err != nil {
+			return nil, err
+		}
+		column.Nullable = nullable == "YES"
+		column.Default = def.String
+		i, ok := index[table]
+		if !ok {
+			i = len(tables)
+			index[table] = i
+			tables = append(tables, domain.SchemaTable{Name: table})
+		}
+		tables[i].Columns = append(tables[i].Columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	fks, err := db.QueryContext(ctx, queries.foreignKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer fks.Close()
+	for fks.Next() {
+		var table, from, target, to string
+		if err := fks.Scan(&table, &from, &target, &to); err != nil {
+			return nil, err
+		}
+		if i, ok := index[table]; ok {
+			setReference(&tables[i], from, target, to)
+		}
+	}
+	return tables, fks.Err()
+}
@@ -0,0 +1,74 @@
+package introspectors
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/internal/core/services"
+)
+
+// TestSqliteIntrospector reads a table with nullable columns from a SQLite database, and checks
+// that they become pointer fields of the imported feature.
+func TestSqliteIntrospector(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`CREATE TABLE customers (id INTEGER PRIMARY KEY);
+CREATE TABLE invoices (
+    id INTEGER PRIMARY KEY,
+    customer_id INTEGER NOT NULL REFERENCES customers(id),
+    total REAL NOT NULL DEFAULT 0,
+    note TEXT,
+    paid_at DATETIME
+);`)
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	introspector, err := NewIntrospector("sqlite://" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer introspector.Close()
+	tables, err := introspector.Tables(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 2 || tables[1].Name != "invoices" {
+		t.Fatalf("got tables %+v, want customers and invoices", tables)
+	}
+	want := []domain.SchemaColumn{
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "customer_id", Type: "INTEGER", References: "customers"},
+		{Name: "total", Type: "REAL", Default: "0"},
+		{Name: "note", Type: "TEXT", Nullable: true},
+		{Name: "paid_at", Type: "DATETIME", Nullable: true},
+	}
+	if !reflect.DeepEqual(tables[1].Columns, want) {
+		t.Errorf("columns = %+v, want %+v", tables[1].Columns, want)
+	}
+
+	specs, _ := services.NewImportService().Features(tables[1:])
+	if len(specs) != 1 {
+		t.Fatalf("got specs %+v, want invoices", specs)
+	}
+	fields, err := services.ParseFields(specs[0].Fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := map[string]string{}
+	for _, f := range fields {
+		types[f.Column] = f.GoType()
+	}
+	wantTypes := map[string]string{"customer_id": "int32", "total": "float32", "note": "*string", "paid_at": "*time.Time"}
+	if !reflect.DeepEqual(types, wantTypes) {
+		t.Errorf("field types = %v, want %v", types, wantTypes)
+	}
+}
@@ -0,0 +1,49 @@
+package introspectors
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+
+	_ "github.com/go-sql-driver/mysql" // registers the mysql driver
+)
+
+// mysqlIntrospector reads the tables of the database of the DSN.
+type mysqlIntrospector struct {
+	db *sql.DB
+}
+
+// The column_type keeps the unsigned and tinyint(1) the Go types depend on. MySQL has the literal
+// defaults of text columns without their quotes, which MariaDB keeps.
+var mysqlQueries = informationSchemaQueries{
+	columns: `
+SELECT c.table_name, c.column_name, c.column_type, c.is_nullable,
+       CASE WHEN c.column_default IS NULL OR c.extra LIKE '%DEFAULT_GENERATED%' OR c.column_default LIKE '''%'
+                 OR c.data_type IN ('tinyint', 'smallint', 'mediumint', 'int', 'bigint', 'decimal', 'float', 'double', 'bit')
+            THEN c.column_default ELSE QUOTE(c.column_default) END,
+       c.column_key = 'PRI'
+FROM information_schema.columns c
+JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE c.table_schema = DATABASE() AND t.table_type = 'BASE TABLE'
+ORDER BY c.table_name, c.ordinal_position`,
+	foreignKeys: `
+SELECT table_name, column_name, referenced_table_name, referenced_column_name
+FROM information_schema.key_column_usage
+WHERE table_schema = DATABASE() AND referenced_table_name IS NOT NULL`,
+}
+
+// Dialect implements ports.ISchemaIntrospector.
+func (m *mysqlIntrospector) Dialect() string {
+	return "mysql"
+}
+
+// Close implements ports.ISchemaIntrospector.
+func (m *mysqlIntrospector) Close() error {
+	return m.db.Close()
+}
+
+// Tables implements ports.ISchemaIntrospector.
+func (m *mysqlIntrospector) Tables(ctx context.Context) ([]domain.SchemaTable, error) {
+	return readInformationSchema(ctx, m.db, mysqlQueries)
+}
@@ -0,0 +1,54 @@
+package introspectors
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // registers the pgx driver
+)
+
+// postgresIntrospector reads the tables of the current schema, usually public.
+type postgresIntrospector struct {
+	db *sql.DB
+}
+
+var postgresQueries = informationSchemaQueries{
+	columns: `
+SELECT c.table_name, c.column_name, c.data_type, c.is_nullable, c.column_default,
+       EXISTS (
+           SELECT 1 FROM information_schema.table_constraints tc
+           JOIN information_schema.key_column_usage k
+             ON k.constraint_name = tc.constraint_name AND k.table_schema = tc.table_schema
+           WHERE tc.constraint_type = 'PRIMARY KEY' AND tc.table_schema = c.table_schema
+             AND tc.table_name = c.table_name AND k.column_name = c.column_name
+       )
+FROM information_schema.columns c
+JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+WHERE c.table_schema = current_schema() AND t.table_type = 'BASE TABLE'
+ORDER BY c.table_name, c.ordinal_position`,
+	foreignKeys: `
+SELECT k.table_name, k.column_name, u.table_name, u.column_name
+FROM information_schema.table_constraints tc
+JOIN information_schema.key_column_usage k
+  ON k.constraint_name = tc.constraint_name AND k.table_schema = tc.table_schema
+JOIN information_schema.constraint_column_usage u
+  ON u.constraint_name = tc.constraint_name AND u.constraint_schema = tc.table_schema
+WHERE tc.constraint_type = 'FOREIGN KEY' AND tc.table_schema = current_schema()`,
+}
+
+// Dialect implements ports.ISchemaIntrospector.
+func (p *postgresIntrospector) Dialect() string {
+	return "postgres"
+}
+
+// Close implements ports.ISchemaIntrospector.
+func (p *postgresIntrospector) Close() error {
+	return p.db.Close()
+}
+
+// Tables implements ports.ISchemaIntrospector.
+func (p *postgresIntrospector) Tables(ctx context.Context) ([]domain.SchemaTable, error) {
+	return readInformationSchema(ctx, p.db, postgresQueries)
+}
@@ -0,0 +1,109 @@
+package introspectors
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+
+	_ "modernc.org/sqlite" // registers the sqlite driver
+)
+
+// sqliteIntrospector reads the schema with the table_info and foreign_key_list pragmas.
+type sqliteIntrospector struct {
+	db *sql.DB
+}
+
+// Dialect implements ports.ISchemaIntrospector.
+func (s *sqliteIntrospector) Dialect() string {
+	return "sqlite"
+}
+
+// Close implements ports.ISchemaIntrospector.
+func (s *sqliteIntrospector) Close() error {
+	return s.db.Close()
+}
+
+// Tables implements ports.ISchemaIntrospector.
+func (s *sqliteIntrospector) Tables(ctx context.Context) ([]domain.SchemaTable, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tables := make([]domain.SchemaTable, 0, len(names))
+	for _, name := range names {
+		table, err := s.table(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// table reads the columns and the foreign keys of the table name.
+func (s *sqliteIntrospector) table(ctx context.Context, name string) (domain.SchemaTable, error) {
+	table := domain.SchemaTable{Name: name}
+	rows, err := s.db.QueryContext(ctx, `SELECT name, type, "notnull", dflt_value, pk FROM pragma_table_info(?)`, name)
+	if err != nil {
+		return table, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var column domain.SchemaColumn
+		var notNull, pk int
+		var def sql.NullString
+		if err := rows.Scan(&column.Name, &column.Type, &notNull, &def, &pk); err != nil {
+			return table, err
+		}
+		column.Nullable = notNull == 0 && pk == 0
+		column.Default = def.String
+		column.PrimaryKey = pk > 0
+		table.Columns = append(table.Columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return table, err
+	}
+
+	// Only the foreign keys to the id of a table are kept, which is what the generators reference.
+	fks, err := s.db.QueryContext(ctx, `SELECT "from", "table", coalesce("to", 'id') FROM pragma_foreign_key_list(?)`, name)
+	if err != nil {
+		return table, err
+	}
+	defer fks.Close()
+	for fks.Next() {
+		var from, target, to string
+		if err := fks.Scan(&from, &target, &to); err != nil {
+			return table, err
+		}
+		setReference(&table, from, target, to)
+	}
+	return table, fks.Err()
+}
+
+// setReference records on the column from of table that it references the column to of target,
+// when to is the id of target.
+func setReference(table *domain.SchemaTable, from, target, to string) {
+	if to != "id" {
+		return
+	}
+	for i := range table.Columns {
+		if table.Columns[i].Name == from {
+			table.Columns[i].References = target
+		}
+	}
+}
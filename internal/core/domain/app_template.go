@@ -3,6 +3,7 @@ package domain
 type AppFlagDomain struct {
 	FeatureName string
 	ProjectName string
+	DB          DBHandle
 }
 
 var AppTemplate = `
@@ -15,21 +16,21 @@ import (
 	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
 	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
 	"github.com/gofiber/fiber/v2"
-	"gorm.io/gorm"
+	"{{ .DB.Import }}"
 )
 
-func AppContainer(app *fiber.App, db *gorm.DB) *fiber.App {
+func AppContainer(app *fiber.App, db {{ .DB.Type }}) *fiber.App {
 	v1 := app.Group("/v1")
 	route := routers.NewRoute(v1)
 	{{ .FeatureName }}App(route, db)
 	return app
 }
 
-func {{ .FeatureName }}App(r routers.RouterImpl, db *gorm.DB) {
+func {{ .FeatureName }}App(r routers.RouterImpl, db {{ .DB.Type }}) {
 	transactorRepo := database.NewTransactorRepo(db)
-	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
 	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
 	{{ .FeatureName | ToLower }}Handlers := handlers.New{{ .FeatureName }}Handler({{ .FeatureName | ToLower }}Srv)
-	r.Create{{ .FeatureName }}Route({{ .FeatureName | ToLower }}Handlers)
+	r.Create{{ .FeatureName }}Routes({{ .FeatureName | ToLower }}Handlers)
 }
 `
@@ -0,0 +1,358 @@
+package domain
+
+// BunModelsTemplate renders the model of a feature for -orm bun. The table is set on bun.BaseModel
+// and the timestamps default to the time of the insert. A -uuid ID defaults to the UUID of the dialect.
+var BunModelsTemplate = `
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type {{ .FeatureName }} struct {
+	bun.BaseModel ` + "`bun:\"table:{{ .Table }}\"`" + `
+
+	{{ if .UseUUID }}ID        string    ` + "`bun:\"id,pk,nullzero,type:{{ .Dialect.UUIDType | ToLower }},default:{{ .Dialect.UUIDDefault }}\" json:\"id\"`" + `{{ else }}ID        uint      ` + "`bun:\"id,pk,autoincrement\" json:\"id\"`" + `{{ end }}
+	CreatedAt time.Time ` + "`bun:\"created_at,nullzero,notnull,default:current_timestamp\" json:\"created_at\"`" + `
+	UpdatedAt time.Time ` + "`bun:\"updated_at,nullzero,notnull,default:current_timestamp\" json:\"updated_at\"`" + `
+{{ range .Fields }}	{{ .Name }} {{ .GoType }} ` + "`bun:\"{{ .Column }}\" json:\"{{ .Column }}\"`" + `
+{{ end }}}
+
+var TN{{ .FeatureName }} = "{{ .Table }}"
+
+func (st *{{ .FeatureName }}) TableName() string {
+	return TN{{ .FeatureName }}
+}
+`
+
+// BunTransactorTemplate renders the transactor for -orm bun. It keeps the bun.Tx in the context and
+// has the same WithinTransaction contract as TransactorTemplate.
+var BunTransactorTemplate = `
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// Idea https://www.kaznacheev.me/posts/en/clean-transactions-in-hexagon/
+type txKey struct{}
+
+// injectTx injects the transaction into the context
+func InjectTx(ctx context.Context, tx *bun.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// extractTx extracts the transaction from the context
+func ExtractTx(ctx context.Context) *bun.Tx {
+	if tx, ok := ctx.Value(txKey{}).(*bun.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+// HelperExtractTx returns the transaction of the context, or db outside of one. Both build the
+// same bun queries.
+func HelperExtractTx(ctx context.Context, db *bun.DB) bun.IDB {
+	if tx := ExtractTx(ctx); tx != nil {
+		return tx
+	}
+	return db
+}
+
+type TransactorImpl struct {
+	db *bun.DB
+}
+
+// BeginTransaction implements IDatabaseTransactor.
+func (d *TransactorImpl) BeginTransaction() (*bun.Tx, error) {
+	tx, err := d.db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return &tx, nil
+}
+
+// RollbackTransaction rolls back the transaction if it was started and returns any error encountered.
+// A transaction that was already committed or rolled back is not an error.
+func (d *TransactorImpl) RollbackTransaction(tx *bun.Tx) error {
+	if tx == nil {
+		return nil // No transaction to rollback
+	}
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// WithinTransaction implements IDatabaseTransactor.
+// WithinTransaction runs the provided function within a transaction context.
+// The transaction is automatically committed if the function completes successfully, or rolled back if an error occurs.
+func (d *TransactorImpl) WithinTransaction(ctx context.Context, tFunc func(ctx context.Context) error) (err error) {
+	// begin transaction
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	// Ensure that the transaction is finalized properly
+	defer func() {
+		if r := recover(); r != nil {
+			_ = d.RollbackTransaction(tx)
+			panic(r) // Re-panic after rollback
+		} else if err != nil {
+			_ = d.RollbackTransaction(tx)
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			log.Printf("failed to commit transaction: %v", commitErr)
+			err = commitErr
+		}
+	}()
+
+	// Run the callback function with the transaction context
+	return tFunc(InjectTx(ctx, tx))
+}
+
+// WithTransactionContextTimeout executes a function within a transaction with a specified context timeout.
+// The transaction is committed if successful, or rolled back if an error occurs or the context times out.
+func (d *TransactorImpl) WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) (err error) {
+	// Create a new context with timeout
+	transactionCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Start a new transaction
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure that the transaction is finalized properly
+	defer func() {
+		if err == nil {
+			err = transactionCtx.Err() // Rollback if the context is done (timeout or cancel)
+		}
+		if err != nil {
+			if rollbackErr := d.RollbackTransaction(tx); rollbackErr != nil {
+				log.Printf("failed to rollback transaction: %v", rollbackErr)
+			}
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			log.Printf("failed to commit transaction: %v", commitErr)
+			err = commitErr
+		}
+	}()
+
+	// Run the callback function with the transaction context
+	return tFunc(InjectTx(transactionCtx, tx))
+}
+
+type IDatabaseTransactor interface {
+	WithinTransaction(context.Context, func(ctx context.Context) error) error
+	WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) error
+	BeginTransaction() (*bun.Tx, error)
+	RollbackTransaction(tx *bun.Tx) error
+}
+
+func NewTransactorRepo(db *bun.DB) IDatabaseTransactor {
+	return &TransactorImpl{db: db}
+}
+`
+
+// BunRepoTemplate renders the repository for -orm bun with the query builder of bun.IDB. It serves
+// both the default and the -pure ports like SqlxRepoTemplate.
+var BunRepoTemplate = `
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+{{ if .PureDomain }}	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+{{ end }}	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/uptrace/bun"
+)
+
+type {{ .FeatureName }}Impl struct {
+	db *bun.DB
+}
+
+func New{{ .FeatureName }}Repository(db *bun.DB) ports.I{{ .FeatureName }}Repository {
+	return &{{ .FeatureName }}Impl{db: db}
+}
+{{ template "mappers" . }}
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.CreatedAt = time.Now()
+	data.UpdatedAt = data.CreatedAt
+
+	// The generated id is scanned back into the model.
+	if _, err := tx.NewInsert().Model(data).Returning("id").Exec(ctx); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	if _, err := tx.NewDelete().Model((*models.{{ .FeatureName }})(nil)).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*{{ template "entity" . }}, error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	var data models.{{ .FeatureName }}
+	if err := tx.NewSelect().Model(&data).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	res := To{{ .FeatureName }}Domain(&data)
+	return &res, nil
+{{ else }}	return &data, nil
+{{ end }}}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]{{ template "entity" . }}], error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	p := pagination.GetFilters[filters.{{ .FeatureName }}Filter](ctx)
+	fp := p.Filters
+
+	orderBy := pagination.NewOrderBy(pagination.SortParams{
+		Sort:           p.Sort,
+		Order:          p.Order,
+		DefaultOrderBy: "updated_at DESC",
+	})
+
+	page, pageSize := p.Page, p.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	data := make([]models.{{ .FeatureName }}, 0, pageSize)
+	query := tx.NewSelect().Model(&data)
+	if fp.ID != "" {
+		query = query.Where("id = ?", fp.ID)
+	}
+
+	// ScanAndCount runs the page and the count of all matching rows.
+	count, err := query.OrderExpr(orderBy).Limit(pageSize).Offset((page - 1) * pageSize).ScanAndCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total := int64(count)
+{{ if .PureDomain }}	rows := make([]domain.{{ .FeatureName }}Domain, 0, len(data))
+	for i := range data {
+		rows = append(rows, To{{ .FeatureName }}Domain(&data[i]))
+	}
+{{ end }}	return &pagination.Pagination[[]{{ template "entity" . }}]{
+		Rows:       {{ if .PureDomain }}rows{{ else }}data{{ end }},
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.UpdatedAt = time.Now()
+	if _, err := tx.NewUpdate().Model(data).ExcludeColumn("id", "created_at").WherePK().Exec(ctx); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+` + repoModeTemplate
+
+var BunStub = `
+package bun
+
+import (
+	"context"
+	"database/sql"
+)
+
+type BaseModel struct{}
+
+type IDB interface {
+	NewSelect() *SelectQuery
+	NewInsert() *InsertQuery
+	NewUpdate() *UpdateQuery
+	NewDelete() *DeleteQuery
+}
+
+type DB struct{}
+
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, error) { return Tx{}, nil }
+func (db *DB) NewSelect() *SelectQuery                                      { return &SelectQuery{} }
+func (db *DB) NewInsert() *InsertQuery                                      { return &InsertQuery{} }
+func (db *DB) NewUpdate() *UpdateQuery                                      { return &UpdateQuery{} }
+func (db *DB) NewDelete() *DeleteQuery                                      { return &DeleteQuery{} }
+
+type Tx struct{}
+
+func (tx Tx) Commit() error             { return nil }
+func (tx Tx) Rollback() error           { return nil }
+func (tx Tx) NewSelect() *SelectQuery   { return &SelectQuery{} }
+func (tx Tx) NewInsert() *InsertQuery   { return &InsertQuery{} }
+func (tx Tx) NewUpdate() *UpdateQuery   { return &UpdateQuery{} }
+func (tx Tx) NewDelete() *DeleteQuery   { return &DeleteQuery{} }
+
+type SelectQuery struct{}
+
+func (q *SelectQuery) Model(model interface{}) *SelectQuery                      { return q }
+func (q *SelectQuery) Where(query string, args ...interface{}) *SelectQuery      { return q }
+func (q *SelectQuery) OrderExpr(query string, args ...interface{}) *SelectQuery  { return q }
+func (q *SelectQuery) Limit(n int) *SelectQuery                                  { return q }
+func (q *SelectQuery) Offset(n int) *SelectQuery                                 { return q }
+func (q *SelectQuery) Scan(ctx context.Context, dest ...interface{}) error       { return nil }
+func (q *SelectQuery) ScanAndCount(ctx context.Context, dest ...interface{}) (int, error) {
+	return 0, nil
+}
+
+type InsertQuery struct{}
+
+func (q *InsertQuery) Model(model interface{}) *InsertQuery                   { return q }
+func (q *InsertQuery) Returning(query string, args ...interface{}) *InsertQuery { return q }
+func (q *InsertQuery) Exec(ctx context.Context, dest ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+type UpdateQuery struct{}
+
+func (q *UpdateQuery) Model(model interface{}) *UpdateQuery        { return q }
+func (q *UpdateQuery) ExcludeColumn(columns ...string) *UpdateQuery { return q }
+func (q *UpdateQuery) WherePK(cols ...string) *UpdateQuery          { return q }
+func (q *UpdateQuery) Exec(ctx context.Context, dest ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+type DeleteQuery struct{}
+
+func (q *DeleteQuery) Model(model interface{}) *DeleteQuery                 { return q }
+func (q *DeleteQuery) Where(query string, args ...interface{}) *DeleteQuery { return q }
+func (q *DeleteQuery) Exec(ctx context.Context, dest ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+`
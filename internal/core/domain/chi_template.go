@@ -0,0 +1,269 @@
+package domain
+
+// ChiHandlerIDTemplate defines "parseID" for chi handlers, see HandlerIDTemplate.
+var ChiHandlerIDTemplate = `{{ define "parseID" }}{{ if eq .IDType "string" }}	id := chi.URLParam(r, "id")
+{{ else }}	parsedID, err := strconv.ParseUint(chi.URLParam(r, "id"), 10, 0)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid ID", Data: err.Error()})
+		return
+	}
+	id := {{ .IDType }}(parsedID)
+{{ end }}{{ end }}`
+
+// ChiHandlerValidateTemplate defines "validate", which rejects a payload breaking the constraints of
+// the fields of the feature.
+var ChiHandlerValidateTemplate = `{{ define "validate" }}	if err := payload.Validate(); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ end }}`
+
+var ChiHandlerTemplate = ChiHandlerIDTemplate + ChiHandlerValidateTemplate + `
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type (
+	I{{ .FeatureName }}Handler interface {
+		HandleGet{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+		HandleGet{{ .FeatureName }}s(w http.ResponseWriter, r *http.Request)
+		HandleUpdate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+		HandleCreate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+		HandleDelete{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+{{ range .Operations }}		Handle{{ .Name }}(w http.ResponseWriter, r *http.Request)
+{{ end }}	}
+	{{ .FeatureName }}Impl struct {
+		{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
+	}
+)
+
+func New{{ .FeatureName }}Handler(
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service,
+) I{{ .FeatureName }}Handler {
+	return &{{ .FeatureName }}Impl{
+		{{ .FeatureName | ToLower }}Service: {{ .FeatureName | ToLower }}Service,
+	}
+}
+
+// readJSON decodes the JSON body of the request into v.
+func readJSON(r *http.Request, v any) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+// writeJSON writes v as the JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// HandleCreate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleCreate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+	var payload domain.{{ .FeatureName }}Domain
+	if err := readJSON(r, &payload); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Create{{ .FeatureName }}(ctx, payload)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleDelete{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleDelete{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, id)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleUpdate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleUpdate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+	var payload domain.{{ .FeatureName }}Domain
+{{ template "parseID" . }}	if err := readJSON(r, &payload); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	payload.ID = id
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, payload)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, id)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }}s implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}s(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{
+		Filters:  filters.{{ .FeatureName }}Filter{ID: query.Get("id")},
+		Sort:     query.Get("sort"),
+		Order:    query.Get("order"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	paramCtx := pagination.SetFilters(ctx, params)
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(paramCtx)
+	writeJSON(w, http.StatusOK, res)
+}
+` + ChiHandlerOperationsTemplate
+
+// ChiHandlerOperationsTemplate renders the chi handlers of the operations of a feature besides its
+// CRUD ones.
+var ChiHandlerOperationsTemplate = `{{ range .Operations }}
+// Handle{{ .Name }} implements I{{ $.FeatureName }}Handler.
+func (h *{{ $.FeatureName }}Impl) Handle{{ .Name }}(w http.ResponseWriter, r *http.Request) {
+{{ if .HasID }}{{ template "parseID" $ }}{{ end }}{{ with .PayloadType $.FeatureName }}	var payload {{ . }}
+	if err := readJSON(r, &payload); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ end }}{{ if and (eq .Body "domain") $.Validate }}{{ template "validate" $ }}{{ end }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ $.FeatureName | ToLower }}Service.{{ .Name }}(ctx{{ .Args }})
+	writeJSON(w, http.StatusOK, res)
+}
+{{ end }}`
+
+// ChiRouterTemplate renders the RouterImpl the chi route files add their methods to. The middlewares
+// given to NewRoute are applied to the sub-router of every feature.
+var ChiRouterTemplate = `
+package routers
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type RouterImpl struct {
+	route       chi.Router
+	middlewares []func(http.Handler) http.Handler
+}
+
+func NewRoute(route chi.Router, middlewares ...func(http.Handler) http.Handler) RouterImpl {
+	return RouterImpl{route: route, middlewares: middlewares}
+}
+`
+
+var ChiRouteTemplate = `
+package routers
+
+import (
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+	"github.com/go-chi/chi/v5"
+)
+
+func (r RouterImpl) Create{{ .FeatureName }}Routes(h handlers.I{{ .FeatureName }}Handler) {
+	r.route.Route("/{{ .Path }}", func(sr chi.Router) {
+		sr.Use(r.middlewares...)
+{{ range .Operations }}		sr.{{ .Func }}("{{ .Path }}", h.Handle{{ .Name }})
+{{ end }}		sr.Get("/", h.HandleGet{{ .FeatureName }}s)
+		sr.Get("/{id}", h.HandleGet{{ .FeatureName }})
+		sr.Post("/", h.HandleCreate{{ .FeatureName }})
+		sr.Put("/{id}", h.HandleUpdate{{ .FeatureName }})
+		sr.Delete("/{id}", h.HandleDelete{{ .FeatureName }})
+	})
+}
+`
+
+var ChiAppTemplate = `
+package app
+
+import (
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/internal/adapters/http/routers"
+	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
+	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
+	"github.com/go-chi/chi/v5"
+	"{{ .DB.Import }}"
+)
+
+func AppContainer(router chi.Router, db {{ .DB.Type }}) chi.Router {
+	router.Route("/v1", func(v1 chi.Router) {
+		route := routers.NewRoute(v1)
+		{{ .FeatureName }}App(route, db)
+	})
+	return router
+}
+
+func {{ .FeatureName }}App(r routers.RouterImpl, db {{ .DB.Type }}) {
+	transactorRepo := database.NewTransactorRepo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
+	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
+	{{ .FeatureName | ToLower }}Handlers := handlers.New{{ .FeatureName }}Handler({{ .FeatureName | ToLower }}Srv)
+	r.Create{{ .FeatureName }}Routes({{ .FeatureName | ToLower }}Handlers)
+}
+`
+
+var ChiStub = `
+package chi
+
+import "net/http"
+
+type Router interface {
+	http.Handler
+	Use(middlewares ...func(http.Handler) http.Handler)
+	With(middlewares ...func(http.Handler) http.Handler) Router
+	Group(fn func(r Router)) Router
+	Route(pattern string, fn func(r Router)) Router
+	Mount(pattern string, h http.Handler)
+	Get(pattern string, h http.HandlerFunc)
+	Post(pattern string, h http.HandlerFunc)
+	Put(pattern string, h http.HandlerFunc)
+	Patch(pattern string, h http.HandlerFunc)
+	Delete(pattern string, h http.HandlerFunc)
+}
+
+type Mux struct{}
+
+func NewRouter() *Mux { return &Mux{} }
+
+func (mx *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request)              {}
+func (mx *Mux) Use(middlewares ...func(http.Handler) http.Handler)            {}
+func (mx *Mux) With(middlewares ...func(http.Handler) http.Handler) Router    { return mx }
+func (mx *Mux) Group(fn func(r Router)) Router                                { return mx }
+func (mx *Mux) Route(pattern string, fn func(r Router)) Router                { return mx }
+func (mx *Mux) Mount(pattern string, h http.Handler)                          {}
+func (mx *Mux) Get(pattern string, h http.HandlerFunc)                        {}
+func (mx *Mux) Post(pattern string, h http.HandlerFunc)                       {}
+func (mx *Mux) Put(pattern string, h http.HandlerFunc)                        {}
+func (mx *Mux) Patch(pattern string, h http.HandlerFunc)                      {}
+func (mx *Mux) Delete(pattern string, h http.HandlerFunc)                     {}
+
+func URLParam(r *http.Request, key string) string { return "" }
+`
@@ -0,0 +1,224 @@
+package domain
+
+var ConnectServerTemplate = GRPCConvertTemplate + `
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pb "github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }}/{{ .FeatureName | ToLower }}pbconnect"
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ {{ .FeatureName | ToLower }}pbconnect.{{ .FeatureName }}ServiceHandler = (*{{ .FeatureName }}Server)(nil)
+
+type {{ .FeatureName }}Server struct {
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
+}
+
+func New{{ .FeatureName }}Server(
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service,
+) *{{ .FeatureName }}Server {
+	return &{{ .FeatureName }}Server{
+		{{ .FeatureName | ToLower }}Service: {{ .FeatureName | ToLower }}Service,
+	}
+}
+
+// Get{{ .FeatureName }} implements {{ .FeatureName | ToLower }}pbconnect.{{ .FeatureName }}ServiceHandler.
+func (s *{{ .FeatureName }}Server) Get{{ .FeatureName }}(ctx context.Context, req *connect.Request[pb.Get{{ .FeatureName }}Request]) (*connect.Response[pb.{{ .FeatureName }}], error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, {{ if eq .IDType "string" }}req.Msg.Id{{ else }}{{ .IDType }}(req.Msg.Id){{ end }})
+	data, err := to{{ .FeatureName }}Response(res)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(data), nil
+}
+
+// Get{{ .FeatureName }}s implements {{ .FeatureName | ToLower }}pbconnect.{{ .FeatureName }}ServiceHandler.
+func (s *{{ .FeatureName }}Server) Get{{ .FeatureName }}s(ctx context.Context, req *connect.Request[pb.Get{{ .FeatureName }}sRequest]) (*connect.Response[pb.Get{{ .FeatureName }}sResponse], error) {
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{
+		Filters:  filters.{{ .FeatureName }}Filter{ID: req.Msg.Id},
+		Sort:     req.Msg.Sort,
+		Order:    req.Msg.Order,
+		Page:     int(req.Msg.Page),
+		PageSize: int(req.Msg.PageSize),
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PageSize < 1 {
+		params.PageSize = 10
+	}
+	res := s.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(pagination.SetFilters(ctx, params))
+	rows := make([]*pb.{{ .FeatureName }}, 0, len(res.Rows))
+	for _, row := range res.Rows {
+		rows = append(rows, to{{ .FeatureName }}Message(row))
+	}
+	return connect.NewResponse(&pb.Get{{ .FeatureName }}sResponse{
+		Rows:       rows,
+		Total:      res.Total,
+		Page:       int32(res.Page),
+		PageSize:   int32(res.PageSize),
+		TotalPages: int32(res.TotalPages),
+	}), nil
+}
+
+// Create{{ .FeatureName }} implements {{ .FeatureName | ToLower }}pbconnect.{{ .FeatureName }}ServiceHandler.
+func (s *{{ .FeatureName }}Server) Create{{ .FeatureName }}(ctx context.Context, req *connect.Request[pb.Create{{ .FeatureName }}Request]) (*connect.Response[pb.Create{{ .FeatureName }}Response], error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Create{{ .FeatureName }}(ctx, to{{ .FeatureName }}Domain(req.Msg.Data))
+	if err := responseError(res); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.Create{{ .FeatureName }}Response{}), nil
+}
+
+// Update{{ .FeatureName }} implements {{ .FeatureName | ToLower }}pbconnect.{{ .FeatureName }}ServiceHandler.
+func (s *{{ .FeatureName }}Server) Update{{ .FeatureName }}(ctx context.Context, req *connect.Request[pb.Update{{ .FeatureName }}Request]) (*connect.Response[pb.{{ .FeatureName }}], error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, to{{ .FeatureName }}Domain(req.Msg.Data))
+	data, err := to{{ .FeatureName }}Response(res)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(data), nil
+}
+
+// Delete{{ .FeatureName }} implements {{ .FeatureName | ToLower }}pbconnect.{{ .FeatureName }}ServiceHandler.
+func (s *{{ .FeatureName }}Server) Delete{{ .FeatureName }}(ctx context.Context, req *connect.Request[pb.Delete{{ .FeatureName }}Request]) (*connect.Response[pb.Delete{{ .FeatureName }}Response], error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, {{ if eq .IDType "string" }}req.Msg.Id{{ else }}{{ .IDType }}(req.Msg.Id){{ end }})
+	if err := responseError(res); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.Delete{{ .FeatureName }}Response{}), nil
+}
+
+// responseError returns the Connect error of a failed service response, or nil.
+func responseError(res utils.APIResponse) error {
+	switch {
+	case res.StatusCode == configs.API_SUCCESS_CODE:
+		return nil
+	case res.StatusMessage == "Not Found":
+		return connect.NewError(connect.CodeNotFound, errors.New(res.StatusMessage))
+	default:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("%s: %v", res.StatusMessage, res.Data))
+	}
+}
+
+// to{{ .FeatureName }}Response converts a service response carrying a {{ .FeatureName }} to its message.
+func to{{ .FeatureName }}Response(res utils.APIResponse) (*pb.{{ .FeatureName }}, error) {
+	if err := responseError(res); err != nil {
+		return nil, err
+	}
+	data, ok := res.Data.(domain.{{ .FeatureName }}Domain)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response data %T", res.Data))
+	}
+	return to{{ .FeatureName }}Message(data), nil
+}
+{{ template "convert" . }}`
+
+// ConnectContainerTemplate renders internal/adapters/app/connect_container.go, which mounts the
+// handlers of every feature, like GRPCContainerTemplate.
+var ConnectContainerTemplate = `
+package app
+
+import (
+	"net/http"
+
+	"{{ .DB.Import }}"
+)
+
+func ConnectContainer(mux *http.ServeMux, db {{ .DB.Type }}) *http.ServeMux {
+	{{ .FeatureName }}ConnectApp(mux, db)
+	return mux
+}
+`
+
+var ConnectAppTemplate = `
+package app
+
+import (
+	"net/http"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	"github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }}/{{ .FeatureName | ToLower }}pbconnect"
+	servers "github.com/{{ .ProjectName }}/internal/adapters/grpc/servers/{{ .FeatureName | ToLower }}"
+	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
+	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
+	"{{ .DB.Import }}"
+)
+
+func {{ .FeatureName }}ConnectApp(mux *http.ServeMux, db {{ .DB.Type }}) {
+	transactorRepo := database.NewTransactorRepo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
+	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
+	path, handler := {{ .FeatureName | ToLower }}pbconnect.New{{ .FeatureName }}ServiceHandler(servers.New{{ .FeatureName }}Server({{ .FeatureName | ToLower }}Srv))
+	mux.Handle(path, handler)
+}
+`
+
+// ConnectPbStub declares what protoc-gen-connect-go generates from ProtoTemplate.
+var ConnectPbStub = `
+package {{ .FeatureName | ToLower }}pbconnect
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	pb "github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }}"
+)
+
+type {{ .FeatureName }}ServiceHandler interface {
+	Get{{ .FeatureName }}(context.Context, *connect.Request[pb.Get{{ .FeatureName }}Request]) (*connect.Response[pb.{{ .FeatureName }}], error)
+	Get{{ .FeatureName }}s(context.Context, *connect.Request[pb.Get{{ .FeatureName }}sRequest]) (*connect.Response[pb.Get{{ .FeatureName }}sResponse], error)
+	Create{{ .FeatureName }}(context.Context, *connect.Request[pb.Create{{ .FeatureName }}Request]) (*connect.Response[pb.Create{{ .FeatureName }}Response], error)
+	Update{{ .FeatureName }}(context.Context, *connect.Request[pb.Update{{ .FeatureName }}Request]) (*connect.Response[pb.{{ .FeatureName }}], error)
+	Delete{{ .FeatureName }}(context.Context, *connect.Request[pb.Delete{{ .FeatureName }}Request]) (*connect.Response[pb.Delete{{ .FeatureName }}Response], error)
+}
+
+func New{{ .FeatureName }}ServiceHandler(svc {{ .FeatureName }}ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	return "/{{ .FeatureName | ToLower }}.v1.{{ .FeatureName }}Service/", http.NotFoundHandler()
+}
+`
+
+var ConnectStub = `
+package connect
+
+type Code uint32
+
+const (
+	CodeInvalidArgument Code = 3
+	CodeNotFound        Code = 5
+	CodeInternal        Code = 13
+)
+
+type Error struct{}
+
+func (e *Error) Error() string { return "" }
+
+func NewError(c Code, underlying error) *Error { return &Error{} }
+
+type Request[T any] struct {
+	Msg *T
+}
+
+func NewRequest[T any](message *T) *Request[T] { return &Request[T]{Msg: message} }
+
+type Response[T any] struct {
+	Msg *T
+}
+
+func NewResponse[T any](message *T) *Response[T] { return &Response[T]{Msg: message} }
+
+type HandlerOption interface{}
+`
@@ -0,0 +1,67 @@
+package domain
+
+// Dialect is the SQL flavour of a database accepted by -db, and what the templates write for it.
+type Dialect struct {
+	Name        string
+	UUIDType    string            // column type of -uuid IDs
+	UUIDDefault string            // SQL expression the database generates -uuid IDs with
+	UUIDSetup   string            // statement UUIDDefault needs first, if any
+	SerialType  string            // column type of auto-increment IDs
+	Now         string            // default of the timestamp columns
+	Types       map[string]string // column types of the Go types of fields
+	NoReturning bool              // INSERT ... RETURNING is not supported, as on MySQL
+	Restate     bool              // a column is altered by restating it whole, as with MODIFY COLUMN on MySQL
+}
+
+// Dialects maps the SQL databases of Databases to their dialect.
+var Dialects = map[string]Dialect{
+	"postgres": {
+		Name:        "postgres",
+		UUIDType:    "UUID",
+		UUIDDefault: "uuid_generate_v4()",
+		UUIDSetup:   `CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`,
+		SerialType:  "BIGSERIAL",
+		Now:         "now()",
+		Types:       PostgresTypes,
+	},
+	"cockroachdb": {
+		Name:        "cockroachdb",
+		UUIDType:    "UUID",
+		UUIDDefault: "gen_random_uuid()",
+		SerialType:  "BIGSERIAL",
+		Now:         "now()",
+		Types:       PostgresTypes,
+	},
+	"mysql": {
+		Name:        "mysql",
+		UUIDType:    "CHAR(36)",
+		UUIDDefault: "(UUID())",
+		SerialType:  "BIGINT UNSIGNED AUTO_INCREMENT",
+		Now:         "CURRENT_TIMESTAMP(6)",
+		Types:       MySQLTypes,
+		NoReturning: true,
+		Restate:     true,
+	},
+	"sqlite": {
+		Name:        "sqlite",
+		UUIDType:    "TEXT",
+		UUIDDefault: "(lower(hex(randomblob(16))))",
+		SerialType:  "INTEGER",
+		Now:         "CURRENT_TIMESTAMP",
+		Types:       SQLiteTypes,
+	},
+}
+
+// DatabaseAliases maps other names of the databases, as found in DATABASE, to the names of Databases.
+var DatabaseAliases = map[string]string{
+	"postgresql": "postgres",
+	"pg":         "postgres",
+	"cockroach":  "cockroachdb",
+	"crdb":       "cockroachdb",
+	"mariadb":    "mysql",
+	"sqlite3":    "sqlite",
+	"mongodb":    "mongo",
+}
+
+// PostgresCompatible lists the databases the Postgres-only libraries, pgx and sqlc, support.
+var PostgresCompatible = []string{"postgres", "cockroachdb"}
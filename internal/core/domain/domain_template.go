@@ -5,6 +5,8 @@ type DomainFlagDomain struct {
 	ProjectName string
 	UseUUID     bool
 	DefaultUUID string
+	Fields      []Field
+	Dialect     Dialect
 }
 
 var DomainTemplate = `
@@ -18,15 +20,14 @@ import (
 
 type {{ .FeatureName }}Domain struct {
 {{ if .UseUUID }}
-	ID                 string    ` + "`gorm:\"type:uuid;primaryKey;default:uuid_generate_v4()\" json:\"id\"`" + `
+	ID                 string    ` + "`gorm:\"type:{{ .Dialect.UUIDType | ToLower }};primaryKey;default:{{ .Dialect.UUIDDefault }}\" json:\"id\"`" + `
 {{ else }}
 	ID                 uint      ` + "`gorm:\"primaryKey;autoIncrement\" json:\"id\"`" + `
 {{ end }}
 	CreatedAt          time.Time ` + "`json:\"created_at\" gorm:\"autoCreateTime\"`" + `
 	UpdatedAt          time.Time ` + "`json:\"updated_at\" gorm:\"autoUpdateTime\"`" + `
-	Field1      string    ` + "`json:\"field_1\"`" + `
-	Field2      string    ` + "`json:\"field_2\"`" + `
-}
+{{ range .Fields }}	{{ .Name }} {{ .GoType }} ` + "`json:\"{{ .JSONName }}\"`" + `
+{{ end }}}
 
 func To{{ .FeatureName }}Domain(data *models.{{ .FeatureName }}) {{ .FeatureName }}Domain {
 	if data == nil {
@@ -43,9 +44,8 @@ func To{{ .FeatureName }}Domain(data *models.{{ .FeatureName }}) {{ .FeatureName
 		ID:                 data.ID,
 		CreatedAt:          data.CreatedAt,
 		UpdatedAt:          data.UpdatedAt,
-		Field1:             data.Field1,
-		Field2:             defaultStringIfEmpty(data.Field2, "No Field2"),
-	}
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
 }
 
 func To{{ .FeatureName }}Model(data {{ .FeatureName }}Domain) *models.{{ .FeatureName }} {
@@ -53,16 +53,28 @@ func To{{ .FeatureName }}Model(data {{ .FeatureName }}Domain) *models.{{ .Featur
 		ID:                 data.ID,
 		CreatedAt:          data.CreatedAt,
 		UpdatedAt:          data.UpdatedAt,
-		Field1:             data.Field1,
-		Field2:             defaultStringIfEmpty(data.Field2, "No Field2"),
-	}
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
 }
+`
 
-// defaultStringIfEmpty returns the default value if the input string is empty
-func defaultStringIfEmpty(value, defaultValue string) string {
-	if value == "" {
-		return defaultValue
-	}
-	return value
-}
+// PureDomainTemplate renders a domain entity without persistence concerns.
+// The model mappers are generated in the repository adapter instead, see PureRepoTemplate.
+var PureDomainTemplate = `
+package domain
+
+import (
+	"time"
+)
+
+type {{ .FeatureName }}Domain struct {
+{{ if .UseUUID }}
+	ID        string    ` + "`json:\"id\"`" + `
+{{ else }}
+	ID        uint      ` + "`json:\"id\"`" + `
+{{ end }}
+	CreatedAt time.Time ` + "`json:\"created_at\"`" + `
+	UpdatedAt time.Time ` + "`json:\"updated_at\"`" + `
+{{ range .Fields }}	{{ .Name }} {{ .GoType }} ` + "`json:\"{{ .JSONName }}\"`" + `
+{{ end }}}
 `
@@ -0,0 +1,246 @@
+package domain
+
+// EchoHandlerIDTemplate defines "parseID" for Echo handlers, see HandlerIDTemplate.
+var EchoHandlerIDTemplate = `{{ define "parseID" }}{{ if eq .IDType "string" }}	id := c.Param("id")
+{{ else }}	parsedID, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid ID", Data: err.Error()})
+	}
+	id := {{ .IDType }}(parsedID)
+{{ end }}{{ end }}`
+
+// EchoHandlerValidateTemplate defines "validate", which rejects a payload breaking the constraints of
+// the fields of the feature.
+var EchoHandlerValidateTemplate = `{{ define "validate" }}	if err := payload.Validate(); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+	}
+{{ end }}`
+
+var EchoHandlerTemplate = EchoHandlerIDTemplate + EchoHandlerValidateTemplate + `
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	I{{ .FeatureName }}Handler interface {
+		HandleGet{{ .FeatureName }}(c echo.Context) error
+		HandleGet{{ .FeatureName }}s(c echo.Context) error
+		HandleUpdate{{ .FeatureName }}(c echo.Context) error
+		HandleCreate{{ .FeatureName }}(c echo.Context) error
+		HandleDelete{{ .FeatureName }}(c echo.Context) error
+{{ range .Operations }}		Handle{{ .Name }}(c echo.Context) error
+{{ end }}	}
+	{{ .FeatureName }}Impl struct {
+		{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
+	}
+)
+
+func New{{ .FeatureName }}Handler(
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service,
+) I{{ .FeatureName }}Handler {
+	return &{{ .FeatureName }}Impl{
+		{{ .FeatureName | ToLower }}Service: {{ .FeatureName | ToLower }}Service,
+	}
+}
+
+// HandleCreate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleCreate{{ .FeatureName }}(c echo.Context) error {
+	var payload domain.{{ .FeatureName }}Domain
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Create{{ .FeatureName }}(ctx, payload)
+	return c.JSON(http.StatusOK, res)
+}
+
+// HandleDelete{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleDelete{{ .FeatureName }}(c echo.Context) error {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, id)
+	return c.JSON(http.StatusOK, res)
+}
+
+// HandleUpdate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleUpdate{{ .FeatureName }}(c echo.Context) error {
+	var payload domain.{{ .FeatureName }}Domain
+{{ template "parseID" . }}	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	payload.ID = id
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, payload)
+	return c.JSON(http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}(c echo.Context) error {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, id)
+	return c.JSON(http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }}s implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}s(c echo.Context) error {
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.QueryParam("page_size"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{
+		Filters:  filters.{{ .FeatureName }}Filter{ID: c.QueryParam("id")},
+		Sort:     c.QueryParam("sort"),
+		Order:    c.QueryParam("order"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+	paramCtx := pagination.SetFilters(ctx, params)
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(paramCtx)
+	return c.JSON(http.StatusOK, res)
+}
+` + EchoHandlerOperationsTemplate
+
+// EchoHandlerOperationsTemplate renders the Echo handlers of the operations of a feature besides its
+// CRUD ones.
+var EchoHandlerOperationsTemplate = `{{ range .Operations }}
+// Handle{{ .Name }} implements I{{ $.FeatureName }}Handler.
+func (h *{{ $.FeatureName }}Impl) Handle{{ .Name }}(c echo.Context) error {
+{{ if .HasID }}{{ template "parseID" $ }}{{ end }}{{ with .PayloadType $.FeatureName }}	var payload {{ . }}
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+	}
+{{ end }}{{ if and (eq .Body "domain") $.Validate }}{{ template "validate" $ }}{{ end }}	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ $.FeatureName | ToLower }}Service.{{ .Name }}(ctx{{ .Args }})
+	return c.JSON(http.StatusOK, res)
+}
+{{ end }}`
+
+// EchoRouterTemplate renders the RouterImpl the Echo route files add their methods to.
+var EchoRouterTemplate = `
+package routers
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+type RouterImpl struct {
+	route *echo.Group
+}
+
+func NewRoute(route *echo.Group) RouterImpl {
+	return RouterImpl{route: route}
+}
+`
+
+var EchoRouteTemplate = `
+package routers
+
+import (
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+)
+
+func (r RouterImpl) Create{{ .FeatureName }}Routes(h handlers.I{{ .FeatureName }}Handler) {
+	group := r.route.Group("/{{ .Path }}")
+{{ range .Operations }}	group.{{ .Method }}("{{ .ColonPath }}", h.Handle{{ .Name }})
+{{ end }}	group.GET("", h.HandleGet{{ .FeatureName }}s)
+	group.GET("/:id", h.HandleGet{{ .FeatureName }})
+	group.POST("", h.HandleCreate{{ .FeatureName }})
+	group.PUT("/:id", h.HandleUpdate{{ .FeatureName }})
+	group.DELETE("/:id", h.HandleDelete{{ .FeatureName }})
+}
+`
+
+var EchoAppTemplate = `
+package app
+
+import (
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/internal/adapters/http/routers"
+	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
+	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
+	"github.com/labstack/echo/v4"
+	"{{ .DB.Import }}"
+)
+
+func AppContainer(db {{ .DB.Type }}) *echo.Echo {
+	app := echo.New()
+	v1 := app.Group("/v1")
+	route := routers.NewRoute(v1)
+	{{ .FeatureName }}App(route, db)
+	return app
+}
+
+func {{ .FeatureName }}App(r routers.RouterImpl, db {{ .DB.Type }}) {
+	transactorRepo := database.NewTransactorRepo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
+	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
+	{{ .FeatureName | ToLower }}Handlers := handlers.New{{ .FeatureName }}Handler({{ .FeatureName | ToLower }}Srv)
+	r.Create{{ .FeatureName }}Routes({{ .FeatureName | ToLower }}Handlers)
+}
+`
+
+var EchoStub = `
+package echo
+
+import "net/http"
+
+type Context interface {
+	Request() *http.Request
+	Param(name string) string
+	QueryParam(name string) string
+	Bind(i interface{}) error
+	JSON(code int, i interface{}) error
+	NoContent(code int) error
+}
+
+type HandlerFunc func(c Context) error
+
+type MiddlewareFunc func(next HandlerFunc) HandlerFunc
+
+type Route struct {
+	Method string
+	Path   string
+	Name   string
+}
+
+type Group struct{}
+
+func (g *Group) Group(prefix string, middleware ...MiddlewareFunc) *Group      { return g }
+func (g *Group) Use(middleware ...MiddlewareFunc)                              {}
+func (g *Group) GET(path string, h HandlerFunc, m ...MiddlewareFunc) *Route    { return &Route{} }
+func (g *Group) POST(path string, h HandlerFunc, m ...MiddlewareFunc) *Route   { return &Route{} }
+func (g *Group) PUT(path string, h HandlerFunc, m ...MiddlewareFunc) *Route    { return &Route{} }
+func (g *Group) PATCH(path string, h HandlerFunc, m ...MiddlewareFunc) *Route  { return &Route{} }
+func (g *Group) DELETE(path string, h HandlerFunc, m ...MiddlewareFunc) *Route { return &Route{} }
+
+type Echo struct{}
+
+func New() *Echo                                                { return &Echo{} }
+func (e *Echo) Group(prefix string, m ...MiddlewareFunc) *Group { return &Group{} }
+func (e *Echo) Use(middleware ...MiddlewareFunc)                {}
+func (e *Echo) Start(address string) error                      { return nil }
+`
@@ -0,0 +1,224 @@
+package domain
+
+// Field is a column of a feature, given on the command line as -fields name:type.
+type Field struct {
+	Name       string // Go field name, e.g. CustomerID
+	Type       string // Go type, e.g. string, uint, time.Time
+	Column     string // column and, unless JSON is set, JSON name, e.g. customer_id
+	Index      string // FieldIndexes option of the column, if any
+	References string // table whose id the column references, e.g. customers
+	Nullable   bool   // the column accepts NULL
+	Default    string // SQL default of the column, if any, e.g. 'pending'
+	Renamed    string // previous name of the column, for the migration renaming it
+	JSON       string // JSON name of the domain field, when it is not Column
+	Required   bool   // Validate rejects the zero value of a string or time.Time field
+	Min        string // minimum length of a string, or minimum of a number, checked by Validate
+	Max        string // maximum length of a string, or maximum of a number, checked by Validate
+	Enum       string // values of a string separated by |, checked by Validate
+	Format     string // one of FieldFormats, checked by Validate
+	Pattern    string // regular expression a string matches, checked by Validate
+}
+
+// JSONName returns the JSON name of the domain field.
+func (f Field) JSONName() string {
+	if f.JSON != "" {
+		return f.JSON
+	}
+	return f.Column
+}
+
+// GoType returns the type of the field in the model and the domain: a pointer to Type when the
+// column is nullable, so that NULL scans to nil.
+func (f Field) GoType() string {
+	if f.Nullable {
+		return "*" + f.Type
+	}
+	return f.Type
+}
+
+// nullableTemplate defines "nullable", the helpers of the adapters converting the pointers of
+// nullable fields from and to the types of their libraries. They are prefixed with the feature,
+// as the adapters of several features share a package.
+var nullableTemplate = `{{ define "nullable" }}
+// {{ .FeatureName | ToLowerCamel }}Value returns the value of a nullable field, or its zero value when it is nil.
+func {{ .FeatureName | ToLowerCamel }}Value[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// {{ .FeatureName | ToLowerCamel }}Pointer returns a pointer to the value of a nullable field, or nil when it is not valid.
+func {{ .FeatureName | ToLowerCamel }}Pointer[T any](v T, valid bool) *T {
+	if !valid {
+		return nil
+	}
+	return &v
+}
+
+// {{ .FeatureName | ToLowerCamel }}Map converts the value of a nullable field with convert, or returns the zero value when it is nil.
+func {{ .FeatureName | ToLowerCamel }}Map[T, R any](v *T, convert func(T) R) R {
+	if v == nil {
+		var zero R
+		return zero
+	}
+	return convert(*v)
+}
+{{ end }}`
+
+// Validated reports whether the field has a constraint for Validate to check.
+func (f Field) Validated() bool {
+	return f.Required || f.Min != "" || f.Max != "" || f.Enum != "" || f.Format != "" || f.Pattern != ""
+}
+
+// FieldFormats lists the formats of strings -fields accepts with format=<format>.
+var FieldFormats = []string{"email", "uuid", "uri"}
+
+// FieldIndexes lists the index options of -fields, e.g. email:string:unique.
+var FieldIndexes = []string{"index", "unique"}
+
+// DefaultFields are used when a feature is generated without -fields.
+var DefaultFields = []Field{
+	{Name: "Field1", Type: "string", Column: "field_1"},
+	{Name: "Field2", Type: "string", Column: "field_2"},
+}
+
+// FieldTypes maps the type names accepted by -fields to Go types.
+var FieldTypes = map[string]string{
+	"string":    "string",
+	"text":      "string",
+	"int":       "int",
+	"integer":   "int",
+	"int32":     "int32",
+	"int64":     "int64",
+	"bigint":    "int64",
+	"uint":      "uint",
+	"uint32":    "uint32",
+	"uint64":    "uint64",
+	"float32":   "float32",
+	"float64":   "float64",
+	"float":     "float64",
+	"decimal":   "float64",
+	"bool":      "bool",
+	"boolean":   "bool",
+	"time":      "time.Time",
+	"time.Time": "time.Time",
+	"timestamp": "time.Time",
+	"datetime":  "time.Time",
+}
+
+// ProtoTypes maps the Go types of fields to protobuf types.
+var ProtoTypes = map[string]string{
+	"string":    "string",
+	"int":       "int64",
+	"int32":     "int32",
+	"int64":     "int64",
+	"uint":      "uint64",
+	"uint32":    "uint32",
+	"uint64":    "uint64",
+	"float32":   "float",
+	"float64":   "double",
+	"bool":      "bool",
+	"time.Time": "google.protobuf.Timestamp",
+}
+
+// ProtoGoTypes maps protobuf types to the Go types protoc-gen-go generates for them.
+var ProtoGoTypes = map[string]string{
+	"string":                    "string",
+	"int32":                     "int32",
+	"int64":                     "int64",
+	"uint32":                    "uint32",
+	"uint64":                    "uint64",
+	"float":                     "float32",
+	"double":                    "float64",
+	"bool":                      "bool",
+	"google.protobuf.Timestamp": "*timestamppb.Timestamp",
+}
+
+// PostgresTypes maps the Go types of fields to PostgreSQL column types.
+var PostgresTypes = map[string]string{
+	"string":    "TEXT",
+	"int":       "BIGINT",
+	"int32":     "INTEGER",
+	"int64":     "BIGINT",
+	"uint":      "BIGINT",
+	"uint32":    "BIGINT",
+	"uint64":    "BIGINT",
+	"float32":   "REAL",
+	"float64":   "DOUBLE PRECISION",
+	"bool":      "BOOLEAN",
+	"time.Time": "TIMESTAMPTZ",
+}
+
+// MySQLTypes maps the Go types of fields to MySQL column types.
+var MySQLTypes = map[string]string{
+	"string":    "VARCHAR(255)",
+	"int":       "BIGINT",
+	"int32":     "INT",
+	"int64":     "BIGINT",
+	"uint":      "BIGINT UNSIGNED",
+	"uint32":    "INT UNSIGNED",
+	"uint64":    "BIGINT UNSIGNED",
+	"float32":   "FLOAT",
+	"float64":   "DOUBLE",
+	"bool":      "BOOLEAN",
+	"time.Time": "DATETIME(6)",
+}
+
+// SQLiteTypes maps the Go types of fields to SQLite column types.
+var SQLiteTypes = map[string]string{
+	"string":    "TEXT",
+	"int":       "INTEGER",
+	"int32":     "INTEGER",
+	"int64":     "INTEGER",
+	"uint":      "INTEGER",
+	"uint32":    "INTEGER",
+	"uint64":    "INTEGER",
+	"float32":   "REAL",
+	"float64":   "REAL",
+	"bool":      "BOOLEAN",
+	"time.Time": "DATETIME",
+}
+
+// SqlcGoTypes maps PostgreSQL column types to the Go types sqlc generates for NOT NULL columns
+// with database/sql.
+var SqlcGoTypes = map[string]string{
+	"TEXT":             "string",
+	"INTEGER":          "int32",
+	"BIGINT":           "int64",
+	"BIGSERIAL":        "int64",
+	"REAL":             "float32",
+	"DOUBLE PRECISION": "float64",
+	"BOOLEAN":          "bool",
+	"TIMESTAMPTZ":      "time.Time",
+	"UUID":             "uuid.UUID",
+}
+
+// SqlcNullGoTypes maps PostgreSQL column types to the Go types sqlc generates for nullable columns
+// with database/sql. Their value is in the field named after the type, e.g. String of
+// sql.NullString.
+var SqlcNullGoTypes = map[string]string{
+	"TEXT":             "sql.NullString",
+	"INTEGER":          "sql.NullInt32",
+	"BIGINT":           "sql.NullInt64",
+	"REAL":             "sql.NullFloat64",
+	"DOUBLE PRECISION": "sql.NullFloat64",
+	"BOOLEAN":          "sql.NullBool",
+	"TIMESTAMPTZ":      "sql.NullTime",
+}
+
+// EntFieldBuilders maps the Go types of fields to the ent field builders declaring them.
+var EntFieldBuilders = map[string]string{
+	"string":    "String",
+	"int":       "Int",
+	"int32":     "Int32",
+	"int64":     "Int64",
+	"uint":      "Uint",
+	"uint32":    "Uint32",
+	"uint64":    "Uint64",
+	"float32":   "Float32",
+	"float64":   "Float",
+	"bool":      "Bool",
+	"time.Time": "Time",
+}
@@ -7,10 +7,68 @@ type GeneratorFlag struct {
 	OutputDir    *string `json:"output"`
 	TemplateName *string `json:"template"`
 	UseUUID      *bool   `json:"use_uuid"`
+	PureDomain   *bool   `json:"pure"`
+	Fields       *string `json:"fields"`
+	HTTP         *string `json:"http"`
+	RPC          *string `json:"rpc"`
+	ORM          *string `json:"orm"`
+	DB           *string `json:"db"`
+	Migration    *string `json:"migration"`
 	Help         *bool   `json:"help"`
 }
 
 type GeneratorFlagDomain struct {
 	FeatureName string
 	ProjectName string
+	UseUUID     bool
+	PureDomain  bool
+	Fields      []Field
+	HTTP        string
+	RPC         string
+	ORM         string
+	DB          string
+	ORMSet      bool // -orm was given, rather than defaulted, so ORM is recorded in the manifest
+	DBSet       bool // -db was given, or the database was imported from, so DB is recorded in the manifest
+	Migration   string
+	Table       string // table of the feature, when it is not the lower-case plural of FeatureName
+	Path        string // path of the routes of the feature, when it is not the lower-case plural of FeatureName
+	Operations  []Operation
+}
+
+// HTTPFrameworks lists the values accepted by -http. The first one is the default, whose
+// templates have no suffix; the others select the "<layer>.<framework>" templates.
+var HTTPFrameworks = []string{"fiber", "gin", "echo", "stdlib", "chi"}
+
+// RPCFrameworks lists the values accepted by -rpc, the same way as HTTPFrameworks.
+var RPCFrameworks = []string{"grpc", "connect"}
+
+// ORMs lists the values accepted by -orm, the same way as HTTPFrameworks. They select the
+// model, repository and transactor templates and the database handle of the app files.
+var ORMs = []string{"gorm", "sqlx", "pgx", "sqlc", "ent", "bun"}
+
+// Databases lists the values accepted by -db. The SQL ones select a dialect of Dialects. With mongo
+// the model, repository, transactor and app templates are the "<layer>.mongo" ones whatever the -orm
+// library.
+var Databases = []string{"postgres", "cockroachdb", "mysql", "sqlite", "mongo"}
+
+// MigrationFormats lists the values accepted by -migration, the same way as HTTPFrameworks:
+// golang-migrate up and down files, or a goose file with both.
+var MigrationFormats = []string{"golang-migrate", "goose"}
+
+// DBHandle is the database handle the repositories and the transactor of an ORM are built with.
+type DBHandle struct {
+	Import string // import path of the package declaring the handle, rendered with the project name
+	Type   string // Go type of the handle, e.g. *gorm.DB
+}
+
+// DBHandles maps each of ORMs, and mongo, to its database handle.
+var DBHandles = map[string]DBHandle{
+	"gorm": {Import: "gorm.io/gorm", Type: "*gorm.DB"},
+	"sqlx": {Import: "github.com/jmoiron/sqlx", Type: "*sqlx.DB"},
+	"pgx":  {Import: "github.com/jackc/pgx/v5/pgxpool", Type: "*pgxpool.Pool"},
+	"sqlc": {Import: "github.com/jmoiron/sqlx", Type: "*sqlx.DB"},
+	"ent":  {Import: "github.com/{{ .ProjectName }}/ent", Type: "*ent.Client"},
+	"bun":  {Import: "github.com/uptrace/bun", Type: "*bun.DB"},
+
+	"mongo": {Import: "go.mongodb.org/mongo-driver/mongo", Type: "*mongo.Database"},
 }
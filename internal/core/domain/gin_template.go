@@ -0,0 +1,253 @@
+package domain
+
+// GinHandlerIDTemplate defines "parseID" for Gin handlers, see HandlerIDTemplate.
+var GinHandlerIDTemplate = `{{ define "parseID" }}{{ if eq .IDType "string" }}	id := c.Param("id")
+{{ else }}	parsedID, err := strconv.ParseUint(c.Param("id"), 10, 0)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid ID", Data: err.Error()})
+		return
+	}
+	id := {{ .IDType }}(parsedID)
+{{ end }}{{ end }}`
+
+// GinHandlerValidateTemplate defines "validate", which rejects a payload breaking the constraints of
+// the fields of the feature.
+var GinHandlerValidateTemplate = `{{ define "validate" }}	if err := payload.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ end }}`
+
+var GinHandlerTemplate = GinHandlerIDTemplate + GinHandlerValidateTemplate + `
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	I{{ .FeatureName }}Handler interface {
+		HandleGet{{ .FeatureName }}(c *gin.Context)
+		HandleGet{{ .FeatureName }}s(c *gin.Context)
+		HandleUpdate{{ .FeatureName }}(c *gin.Context)
+		HandleCreate{{ .FeatureName }}(c *gin.Context)
+		HandleDelete{{ .FeatureName }}(c *gin.Context)
+{{ range .Operations }}		Handle{{ .Name }}(c *gin.Context)
+{{ end }}	}
+	{{ .FeatureName }}Impl struct {
+		{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
+	}
+)
+
+func New{{ .FeatureName }}Handler(
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service,
+) I{{ .FeatureName }}Handler {
+	return &{{ .FeatureName }}Impl{
+		{{ .FeatureName | ToLower }}Service: {{ .FeatureName | ToLower }}Service,
+	}
+}
+
+// HandleCreate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleCreate{{ .FeatureName }}(c *gin.Context) {
+	var payload domain.{{ .FeatureName }}Domain
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Create{{ .FeatureName }}(ctx, payload)
+	c.JSON(http.StatusOK, res)
+}
+
+// HandleDelete{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleDelete{{ .FeatureName }}(c *gin.Context) {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, id)
+	c.JSON(http.StatusOK, res)
+}
+
+// HandleUpdate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleUpdate{{ .FeatureName }}(c *gin.Context) {
+	var payload domain.{{ .FeatureName }}Domain
+{{ template "parseID" . }}	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	payload.ID = id
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, payload)
+	c.JSON(http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}(c *gin.Context) {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, id)
+	c.JSON(http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }}s implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}s(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{
+		Filters:  filters.{{ .FeatureName }}Filter{ID: c.Query("id")},
+		Sort:     c.Query("sort"),
+		Order:    c.Query("order"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	paramCtx := pagination.SetFilters(ctx, params)
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(paramCtx)
+	c.JSON(http.StatusOK, res)
+}
+` + GinHandlerOperationsTemplate
+
+// GinHandlerOperationsTemplate renders the Gin handlers of the operations of a feature besides its
+// CRUD ones.
+var GinHandlerOperationsTemplate = `{{ range .Operations }}
+// Handle{{ .Name }} implements I{{ $.FeatureName }}Handler.
+func (h *{{ $.FeatureName }}Impl) Handle{{ .Name }}(c *gin.Context) {
+{{ if .HasID }}{{ template "parseID" $ }}{{ end }}{{ with .PayloadType $.FeatureName }}	var payload {{ . }}
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ end }}{{ if and (eq .Body "domain") $.Validate }}{{ template "validate" $ }}{{ end }}	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ $.FeatureName | ToLower }}Service.{{ .Name }}(ctx{{ .Args }})
+	c.JSON(http.StatusOK, res)
+}
+{{ end }}`
+
+// GinRouterTemplate renders the RouterImpl the Gin route files add their methods to.
+// The project template only ships the Fiber one, so it is generated once per project.
+var GinRouterTemplate = `
+package routers
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type RouterImpl struct {
+	route *gin.RouterGroup
+}
+
+func NewRoute(route *gin.RouterGroup) RouterImpl {
+	return RouterImpl{route: route}
+}
+`
+
+var GinRouteTemplate = `
+package routers
+
+import (
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+)
+
+func (r RouterImpl) Create{{ .FeatureName }}Routes(h handlers.I{{ .FeatureName }}Handler) {
+	group := r.route.Group("/{{ .Path }}")
+{{ range .Operations }}	group.{{ .Method }}("{{ .ColonPath }}", h.Handle{{ .Name }})
+{{ end }}	group.GET("", h.HandleGet{{ .FeatureName }}s)
+	group.GET("/:id", h.HandleGet{{ .FeatureName }})
+	group.POST("", h.HandleCreate{{ .FeatureName }})
+	group.PUT("/:id", h.HandleUpdate{{ .FeatureName }})
+	group.DELETE("/:id", h.HandleDelete{{ .FeatureName }})
+}
+`
+
+var GinAppTemplate = `
+package app
+
+import (
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/internal/adapters/http/routers"
+	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
+	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
+	"github.com/gin-gonic/gin"
+	"{{ .DB.Import }}"
+)
+
+func AppContainer(app *gin.Engine, db {{ .DB.Type }}) *gin.Engine {
+	v1 := app.Group("/v1")
+	route := routers.NewRoute(v1)
+	{{ .FeatureName }}App(route, db)
+	return app
+}
+
+func {{ .FeatureName }}App(r routers.RouterImpl, db {{ .DB.Type }}) {
+	transactorRepo := database.NewTransactorRepo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
+	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
+	{{ .FeatureName | ToLower }}Handlers := handlers.New{{ .FeatureName }}Handler({{ .FeatureName | ToLower }}Srv)
+	r.Create{{ .FeatureName }}Routes({{ .FeatureName | ToLower }}Handlers)
+}
+`
+
+var GinStub = `
+package gin
+
+import "net/http"
+
+type H map[string]any
+
+type Context struct {
+	Request *http.Request
+}
+
+func (c *Context) Param(key string) string                      { return "" }
+func (c *Context) Query(key string) string                      { return "" }
+func (c *Context) DefaultQuery(key, defaultValue string) string { return defaultValue }
+func (c *Context) ShouldBindJSON(obj any) error                 { return nil }
+func (c *Context) ShouldBindQuery(obj any) error                { return nil }
+func (c *Context) JSON(code int, obj any)                       {}
+func (c *Context) AbortWithStatusJSON(code int, jsonObj any)    {}
+func (c *Context) Next()                                        {}
+
+type HandlerFunc func(*Context)
+
+type IRoutes interface {
+	Use(middleware ...HandlerFunc) IRoutes
+	GET(relativePath string, handlers ...HandlerFunc) IRoutes
+	POST(relativePath string, handlers ...HandlerFunc) IRoutes
+	PUT(relativePath string, handlers ...HandlerFunc) IRoutes
+	PATCH(relativePath string, handlers ...HandlerFunc) IRoutes
+	DELETE(relativePath string, handlers ...HandlerFunc) IRoutes
+}
+
+type RouterGroup struct{}
+
+func (g *RouterGroup) Group(relativePath string, handlers ...HandlerFunc) *RouterGroup { return g }
+func (g *RouterGroup) Use(middleware ...HandlerFunc) IRoutes                         { return g }
+func (g *RouterGroup) GET(relativePath string, handlers ...HandlerFunc) IRoutes      { return g }
+func (g *RouterGroup) POST(relativePath string, handlers ...HandlerFunc) IRoutes     { return g }
+func (g *RouterGroup) PUT(relativePath string, handlers ...HandlerFunc) IRoutes      { return g }
+func (g *RouterGroup) PATCH(relativePath string, handlers ...HandlerFunc) IRoutes    { return g }
+func (g *RouterGroup) DELETE(relativePath string, handlers ...HandlerFunc) IRoutes   { return g }
+
+type Engine struct {
+	RouterGroup
+}
+
+func New() *Engine                        { return &Engine{} }
+func Default() *Engine                    { return &Engine{} }
+func (e *Engine) Run(addr ...string) error { return nil }
+`
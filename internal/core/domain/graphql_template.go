@@ -0,0 +1,321 @@
+package domain
+
+type GraphQLFlagDomain struct {
+	FeatureName string
+	ProjectName string
+	IDType      string
+	Fields      []GraphQLField
+	Nullable    bool // a field is nullable, so the conversions need the "nullable" helpers
+}
+
+// GraphQLField is a field of the GraphQL type of a feature, with the Go expressions converting it
+// from the domain struct d to the gqlgen model and from the gqlgen input in to the domain struct.
+type GraphQLField struct {
+	Name        string // Go field name in the domain struct and the gqlgen model, e.g. CustomerID
+	GraphQLName string // e.g. customerId
+	GraphQLType string // e.g. Int, Time
+	Nullable    bool   // the field is nullable in the schema, so gqlgen generates a pointer for it
+	ToModel     string // e.g. int(d.CustomerID)
+	FromInput   string // e.g. uint(in.CustomerID)
+}
+
+// GraphQLTypes maps the Go types of fields to the GraphQL types gqlgen binds to them.
+var GraphQLTypes = map[string]string{
+	"string":    "String",
+	"int":       "Int",
+	"int32":     "Int",
+	"int64":     "Int",
+	"uint":      "Int",
+	"uint32":    "Int",
+	"uint64":    "Int",
+	"float32":   "Float",
+	"float64":   "Float",
+	"bool":      "Boolean",
+	"time.Time": "Time",
+}
+
+// GraphQLGoTypes maps GraphQL types to the Go types of the models gqlgen generates.
+var GraphQLGoTypes = map[string]string{
+	"String":  "string",
+	"Int":     "int",
+	"Float":   "float64",
+	"Boolean": "bool",
+	"Time":    "time.Time",
+}
+
+// GqlgenConfigTemplate is written once per project, when no gqlgen.yml exists.
+var GqlgenConfigTemplate = `schema:
+  - internal/adapters/graphql/schema/*.graphqls
+
+exec:
+  filename: internal/adapters/graphql/generated.go
+  package: graphql
+
+model:
+  filename: internal/adapters/graphql/model/models_gen.go
+  package: model
+
+resolver:
+  layout: follow-schema
+  dir: internal/adapters/graphql
+  package: graphql
+  filename_template: "{name}.resolvers.go"
+`
+
+// GraphQLBaseSchemaTemplate declares the root types the feature schemas extend. It is written
+// once per project, when missing.
+var GraphQLBaseSchemaTemplate = `scalar Time
+
+type Query
+
+type Mutation
+`
+
+var GraphQLSchemaTemplate = `type {{ .FeatureName }} {
+  id: ID!
+  createdAt: Time!
+  updatedAt: Time!
+{{- range .Fields }}
+  {{ .GraphQLName }}: {{ .GraphQLType }}{{ if not .Nullable }}!{{ end }}
+{{- end }}
+}
+
+input {{ .FeatureName }}Input {
+{{- range .Fields }}
+  {{ .GraphQLName }}: {{ .GraphQLType }}{{ if not .Nullable }}!{{ end }}
+{{- end }}
+}
+
+type {{ .FeatureName }}Page {
+  rows: [{{ .FeatureName }}!]!
+  total: Int!
+  page: Int!
+  pageSize: Int!
+  totalPages: Int!
+}
+
+extend type Query {
+  {{ .FeatureName | ToLowerCamel }}(id: ID!): {{ .FeatureName }}
+  {{ .FeatureName | Pluralize | ToLowerCamel }}(page: Int = 1, pageSize: Int = 10, sort: String, order: String, id: String): {{ .FeatureName }}Page!
+}
+
+extend type Mutation {
+  create{{ .FeatureName }}(input: {{ .FeatureName }}Input!): Boolean!
+  update{{ .FeatureName }}(id: ID!, input: {{ .FeatureName }}Input!): {{ .FeatureName }}!
+  delete{{ .FeatureName }}(id: ID!): Boolean!
+}
+`
+
+// GraphQLResolverTemplate renders the resolvers in the layout gqlgen generates them, so that
+// gqlgen keeps their implementations when it regenerates the file. They use the feature's service
+// from the Resolver struct of gqlgen's resolver.go.
+var GraphQLResolverTemplate = `package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/graphql/model"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+)
+
+// Create{{ .FeatureName }} is the resolver for the create{{ .FeatureName }} field.
+func (r *mutationResolver) Create{{ .FeatureName }}(ctx context.Context, input model.{{ .FeatureName }}Input) (bool, error) {
+	res := r.{{ .FeatureName }}Service.Create{{ .FeatureName }}(ctx, from{{ .FeatureName }}Input(input))
+	if err := {{ .FeatureName | ToLowerCamel }}ResponseError(res); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Update{{ .FeatureName }} is the resolver for the update{{ .FeatureName }} field.
+func (r *mutationResolver) Update{{ .FeatureName }}(ctx context.Context, id string, input model.{{ .FeatureName }}Input) (*model.{{ .FeatureName }}, error) {
+	{{ .FeatureName | ToLowerCamel }}ID, err := parse{{ .FeatureName }}ID(id)
+	if err != nil {
+		return nil, err
+	}
+	payload := from{{ .FeatureName }}Input(input)
+	payload.ID = {{ .FeatureName | ToLowerCamel }}ID
+	return to{{ .FeatureName }}Response(r.{{ .FeatureName }}Service.Update{{ .FeatureName }}(ctx, payload))
+}
+
+// Delete{{ .FeatureName }} is the resolver for the delete{{ .FeatureName }} field.
+func (r *mutationResolver) Delete{{ .FeatureName }}(ctx context.Context, id string) (bool, error) {
+	{{ .FeatureName | ToLowerCamel }}ID, err := parse{{ .FeatureName }}ID(id)
+	if err != nil {
+		return false, err
+	}
+	res := r.{{ .FeatureName }}Service.Delete{{ .FeatureName }}(ctx, {{ .FeatureName | ToLowerCamel }}ID)
+	if err := {{ .FeatureName | ToLowerCamel }}ResponseError(res); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// {{ .FeatureName }} is the resolver for the {{ .FeatureName | ToLowerCamel }} field.
+func (r *queryResolver) {{ .FeatureName }}(ctx context.Context, id string) (*model.{{ .FeatureName }}, error) {
+	{{ .FeatureName | ToLowerCamel }}ID, err := parse{{ .FeatureName }}ID(id)
+	if err != nil {
+		return nil, err
+	}
+	res := r.{{ .FeatureName }}Service.Get{{ .FeatureName }}(ctx, {{ .FeatureName | ToLowerCamel }}ID)
+	if res.StatusMessage == "Not Found" {
+		return nil, nil
+	}
+	return to{{ .FeatureName }}Response(res)
+}
+
+// {{ .FeatureName }}s is the resolver for the {{ .FeatureName | Pluralize | ToLowerCamel }} field.
+func (r *queryResolver) {{ .FeatureName }}s(ctx context.Context, page *int, pageSize *int, sort *string, order *string, id *string) (*model.{{ .FeatureName }}Page, error) {
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{Page: 1, PageSize: 10}
+	if page != nil {
+		params.Page = *page
+	}
+	if pageSize != nil {
+		params.PageSize = *pageSize
+	}
+	if sort != nil {
+		params.Sort = *sort
+	}
+	if order != nil {
+		params.Order = *order
+	}
+	if id != nil {
+		params.Filters.ID = *id
+	}
+	res := r.{{ .FeatureName }}Service.Get{{ .FeatureName }}s(pagination.SetFilters(ctx, params))
+	rows := make([]*model.{{ .FeatureName }}, 0, len(res.Rows))
+	for _, row := range res.Rows {
+		rows = append(rows, to{{ .FeatureName }}Model(row))
+	}
+	return &model.{{ .FeatureName }}Page{
+		Rows:       rows,
+		Total:      int(res.Total),
+		Page:       res.Page,
+		PageSize:   res.PageSize,
+		TotalPages: res.TotalPages,
+	}, nil
+}
+`
+
+// GraphQLConvertTemplate holds the helpers of the resolvers. They live in their own file because
+// gqlgen comments out any code of a resolvers file that is not a resolver.
+var GraphQLConvertTemplate = `package graphql
+
+import (
+	"fmt"
+{{- if ne .IDType "string" }}
+	"strconv"
+{{- end }}
+
+	"github.com/{{ .ProjectName }}/internal/adapters/graphql/model"
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+)
+
+// parse{{ .FeatureName }}ID converts a GraphQL ID to the ID of a {{ .FeatureName }}.
+func parse{{ .FeatureName }}ID(id string) ({{ .IDType }}, error) {
+{{- if eq .IDType "string" }}
+	return id, nil
+{{- else }}
+	parsedID, err := strconv.ParseUint(id, 10, 0)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q: %w", id, err)
+	}
+	return {{ .IDType }}(parsedID), nil
+{{- end }}
+}
+
+// to{{ .FeatureName }}Model converts the domain struct to its GraphQL model.
+func to{{ .FeatureName }}Model(d domain.{{ .FeatureName }}Domain) *model.{{ .FeatureName }} {
+	return &model.{{ .FeatureName }}{
+		ID:        {{ if eq .IDType "string" }}d.ID{{ else }}strconv.FormatUint(uint64(d.ID), 10){{ end }},
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+{{- range .Fields }}
+		{{ .Name }}: {{ .ToModel }},
+{{- end }}
+	}
+}
+
+// from{{ .FeatureName }}Input converts a GraphQL input to the domain struct.
+func from{{ .FeatureName }}Input(in model.{{ .FeatureName }}Input) domain.{{ .FeatureName }}Domain {
+	return domain.{{ .FeatureName }}Domain{
+{{- range .Fields }}
+		{{ .Name }}: {{ .FromInput }},
+{{- end }}
+	}
+}
+{{ if .Nullable }}{{ template "nullable" . }}{{ end }}
+// {{ .FeatureName | ToLowerCamel }}ResponseError returns the error of a failed service response, or nil.
+func {{ .FeatureName | ToLowerCamel }}ResponseError(res utils.APIResponse) error {
+	if res.StatusCode == configs.API_SUCCESS_CODE {
+		return nil
+	}
+	return fmt.Errorf("%s: %v", res.StatusMessage, res.Data)
+}
+
+// to{{ .FeatureName }}Response converts a service response carrying a {{ .FeatureName }} to its GraphQL model.
+func to{{ .FeatureName }}Response(res utils.APIResponse) (*model.{{ .FeatureName }}, error) {
+	if err := {{ .FeatureName | ToLowerCamel }}ResponseError(res); err != nil {
+		return nil, err
+	}
+	data, ok := res.Data.(domain.{{ .FeatureName }}Domain)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response data %T", res.Data)
+	}
+	return to{{ .FeatureName }}Model(data), nil
+}
+` + nullableTemplate
+
+// GraphQLModelStub declares the models gqlgen generates from GraphQLSchemaTemplate.
+var GraphQLModelStub = `
+package model
+
+import "time"
+
+var _ time.Time
+
+type {{ .FeatureName }} struct {
+	ID        string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+{{- range .Fields }}
+	{{ .Name }} {{ if .Nullable }}*{{ end }}{{ .GraphQLType | GraphQLGoType }}
+{{- end }}
+}
+
+type {{ .FeatureName }}Input struct {
+{{- range .Fields }}
+	{{ .Name }} {{ if .Nullable }}*{{ end }}{{ .GraphQLType | GraphQLGoType }}
+{{- end }}
+}
+
+type {{ .FeatureName }}Page struct {
+	Rows       []*{{ .FeatureName }}
+	Total      int
+	Page       int
+	PageSize   int
+	TotalPages int
+}
+`
+
+// GraphQLResolverStub declares gqlgen's resolver.go, with the feature's service added to Resolver
+// as the generated resolvers expect.
+var GraphQLResolverStub = `
+package graphql
+
+import ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+
+type Resolver struct {
+	{{ .FeatureName }}Service ports.I{{ .FeatureName }}Service
+}
+
+type mutationResolver struct{ *Resolver }
+
+type queryResolver struct{ *Resolver }
+`
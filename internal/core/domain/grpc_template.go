@@ -0,0 +1,408 @@
+package domain
+
+type GRPCFlagDomain struct {
+	FeatureName string
+	ProjectName string
+	IDType      string
+	IDProtoType string
+	Fields      []ProtoField // id, timestamps and the feature's fields, numbered in this order
+	Nullable    bool         // a field is nullable, so the conversions need the "nullable" helpers
+	DB          DBHandle
+}
+
+// ProtoField is a field of the protobuf message of a feature, with the Go expressions converting
+// it from the domain struct d and to it from the message m.
+type ProtoField struct {
+	Name      string // Go field name in the domain struct, e.g. CustomerID
+	Column    string // protobuf field name, e.g. customer_id
+	GoName    string // Go field name in the generated message, e.g. CustomerId
+	ProtoType string // e.g. uint64, google.protobuf.Timestamp
+	GoType    string // Go type of the generated message field, e.g. uint64
+	Optional  bool   // the field of a nullable scalar is optional, so it has a pointer type
+	Number    int
+	ToProto   string // e.g. uint64(d.CustomerID)
+	FromProto string // e.g. uint(m.CustomerId)
+}
+
+var ProtoTemplate = `syntax = "proto3";
+
+package {{ .FeatureName | ToLower }}.v1;
+
+option go_package = "github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }};{{ .FeatureName | ToLower }}pb";
+
+import "google/protobuf/timestamp.proto";
+
+message {{ .FeatureName }} {
+{{- range .Fields }}
+  {{ if .Optional }}optional {{ end }}{{ .ProtoType }} {{ .Column }} = {{ .Number }};
+{{- end }}
+}
+
+message Get{{ .FeatureName }}Request {
+  {{ .IDProtoType }} id = 1;
+}
+
+message Get{{ .FeatureName }}sRequest {
+  int32 page = 1;
+  int32 page_size = 2;
+  string sort = 3;
+  string order = 4;
+  string id = 5;
+}
+
+message Get{{ .FeatureName }}sResponse {
+  repeated {{ .FeatureName }} rows = 1;
+  int64 total = 2;
+  int32 page = 3;
+  int32 page_size = 4;
+  int32 total_pages = 5;
+}
+
+message Create{{ .FeatureName }}Request {
+  {{ .FeatureName }} data = 1;
+}
+
+message Create{{ .FeatureName }}Response {}
+
+message Update{{ .FeatureName }}Request {
+  {{ .FeatureName }} data = 1;
+}
+
+message Delete{{ .FeatureName }}Request {
+  {{ .IDProtoType }} id = 1;
+}
+
+message Delete{{ .FeatureName }}Response {}
+
+service {{ .FeatureName }}Service {
+  rpc Get{{ .FeatureName }}(Get{{ .FeatureName }}Request) returns ({{ .FeatureName }});
+  rpc Get{{ .FeatureName }}s(Get{{ .FeatureName }}sRequest) returns (Get{{ .FeatureName }}sResponse);
+  rpc Create{{ .FeatureName }}(Create{{ .FeatureName }}Request) returns (Create{{ .FeatureName }}Response);
+  rpc Update{{ .FeatureName }}(Update{{ .FeatureName }}Request) returns ({{ .FeatureName }});
+  rpc Delete{{ .FeatureName }}(Delete{{ .FeatureName }}Request) returns (Delete{{ .FeatureName }}Response);
+}
+`
+
+// GRPCConvertTemplate defines "convert", the conversions between the domain struct and its message,
+// with the helpers of the nullable fields if there are any.
+var GRPCConvertTemplate = `{{ define "convert" }}
+// to{{ .FeatureName }}Message converts the domain struct to its message.
+func to{{ .FeatureName }}Message(d domain.{{ .FeatureName }}Domain) *pb.{{ .FeatureName }} {
+	return &pb.{{ .FeatureName }}{
+{{- range .Fields }}
+		{{ .GoName }}: {{ .ToProto }},
+{{- end }}
+	}
+}
+
+// to{{ .FeatureName }}Domain converts a message to the domain struct.
+func to{{ .FeatureName }}Domain(m *pb.{{ .FeatureName }}) domain.{{ .FeatureName }}Domain {
+	if m == nil {
+		return domain.{{ .FeatureName }}Domain{}
+	}
+	return domain.{{ .FeatureName }}Domain{
+{{- range .Fields }}
+		{{ .Name }}: {{ .FromProto }},
+{{- end }}
+	}
+}
+{{ if .Nullable }}{{ template "nullable" . }}{{ end }}{{ end }}` + nullableTemplate
+
+var GRPCServerTemplate = GRPCConvertTemplate + `
+package servers
+
+import (
+	"context"
+
+	pb "github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }}"
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type {{ .FeatureName }}Server struct {
+	pb.Unimplemented{{ .FeatureName }}ServiceServer
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
+}
+
+func New{{ .FeatureName }}Server(
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service,
+) *{{ .FeatureName }}Server {
+	return &{{ .FeatureName }}Server{
+		{{ .FeatureName | ToLower }}Service: {{ .FeatureName | ToLower }}Service,
+	}
+}
+
+// Get{{ .FeatureName }} implements pb.{{ .FeatureName }}ServiceServer.
+func (s *{{ .FeatureName }}Server) Get{{ .FeatureName }}(ctx context.Context, req *pb.Get{{ .FeatureName }}Request) (*pb.{{ .FeatureName }}, error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, {{ if eq .IDType "string" }}req.Id{{ else }}{{ .IDType }}(req.Id){{ end }})
+	return to{{ .FeatureName }}Response(res)
+}
+
+// Get{{ .FeatureName }}s implements pb.{{ .FeatureName }}ServiceServer.
+func (s *{{ .FeatureName }}Server) Get{{ .FeatureName }}s(ctx context.Context, req *pb.Get{{ .FeatureName }}sRequest) (*pb.Get{{ .FeatureName }}sResponse, error) {
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{
+		Filters:  filters.{{ .FeatureName }}Filter{ID: req.Id},
+		Sort:     req.Sort,
+		Order:    req.Order,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.PageSize < 1 {
+		params.PageSize = 10
+	}
+	res := s.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(pagination.SetFilters(ctx, params))
+	rows := make([]*pb.{{ .FeatureName }}, 0, len(res.Rows))
+	for _, row := range res.Rows {
+		rows = append(rows, to{{ .FeatureName }}Message(row))
+	}
+	return &pb.Get{{ .FeatureName }}sResponse{
+		Rows:       rows,
+		Total:      res.Total,
+		Page:       int32(res.Page),
+		PageSize:   int32(res.PageSize),
+		TotalPages: int32(res.TotalPages),
+	}, nil
+}
+
+// Create{{ .FeatureName }} implements pb.{{ .FeatureName }}ServiceServer.
+func (s *{{ .FeatureName }}Server) Create{{ .FeatureName }}(ctx context.Context, req *pb.Create{{ .FeatureName }}Request) (*pb.Create{{ .FeatureName }}Response, error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Create{{ .FeatureName }}(ctx, to{{ .FeatureName }}Domain(req.Data))
+	if err := responseError(res); err != nil {
+		return nil, err
+	}
+	return &pb.Create{{ .FeatureName }}Response{}, nil
+}
+
+// Update{{ .FeatureName }} implements pb.{{ .FeatureName }}ServiceServer.
+func (s *{{ .FeatureName }}Server) Update{{ .FeatureName }}(ctx context.Context, req *pb.Update{{ .FeatureName }}Request) (*pb.{{ .FeatureName }}, error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, to{{ .FeatureName }}Domain(req.Data))
+	return to{{ .FeatureName }}Response(res)
+}
+
+// Delete{{ .FeatureName }} implements pb.{{ .FeatureName }}ServiceServer.
+func (s *{{ .FeatureName }}Server) Delete{{ .FeatureName }}(ctx context.Context, req *pb.Delete{{ .FeatureName }}Request) (*pb.Delete{{ .FeatureName }}Response, error) {
+	res := s.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, {{ if eq .IDType "string" }}req.Id{{ else }}{{ .IDType }}(req.Id){{ end }})
+	if err := responseError(res); err != nil {
+		return nil, err
+	}
+	return &pb.Delete{{ .FeatureName }}Response{}, nil
+}
+
+// responseError returns the gRPC error of a failed service response, or nil.
+func responseError(res utils.APIResponse) error {
+	switch {
+	case res.StatusCode == configs.API_SUCCESS_CODE:
+		return nil
+	case res.StatusMessage == "Not Found":
+		return status.Error(codes.NotFound, res.StatusMessage)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", res.StatusMessage, res.Data)
+	}
+}
+
+// to{{ .FeatureName }}Response converts a service response carrying a {{ .FeatureName }} to its message.
+func to{{ .FeatureName }}Response(res utils.APIResponse) (*pb.{{ .FeatureName }}, error) {
+	if err := responseError(res); err != nil {
+		return nil, err
+	}
+	data, ok := res.Data.(domain.{{ .FeatureName }}Domain)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected response data %T", res.Data)
+	}
+	return to{{ .FeatureName }}Message(data), nil
+}
+{{ template "convert" . }}`
+
+// GRPCContainerTemplate renders internal/adapters/app/grpc_container.go, which registers the
+// servers of every feature. It is written with the first feature, and each further one adds its
+// <Feature>GRPCApp call before the return.
+var GRPCContainerTemplate = `
+package app
+
+import (
+	"google.golang.org/grpc"
+	"{{ .DB.Import }}"
+)
+
+func GRPCContainer(s *grpc.Server, db {{ .DB.Type }}) *grpc.Server {
+	{{ .FeatureName }}GRPCApp(s, db)
+	return s
+}
+`
+
+var GRPCAppTemplate = `
+package app
+
+import (
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	pb "github.com/{{ .ProjectName }}/internal/adapters/grpc/pb/{{ .FeatureName | ToLower }}"
+	servers "github.com/{{ .ProjectName }}/internal/adapters/grpc/servers/{{ .FeatureName | ToLower }}"
+	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
+	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
+	"google.golang.org/grpc"
+	"{{ .DB.Import }}"
+)
+
+func {{ .FeatureName }}GRPCApp(s grpc.ServiceRegistrar, db {{ .DB.Type }}) {
+	transactorRepo := database.NewTransactorRepo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
+	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
+	pb.Register{{ .FeatureName }}ServiceServer(s, servers.New{{ .FeatureName }}Server({{ .FeatureName | ToLower }}Srv))
+}
+`
+
+// GRPCPbStub declares what protoc-gen-go and protoc-gen-go-grpc generate from ProtoTemplate, so
+// the adapter can be verified without running protoc.
+var GRPCPbStub = `
+package {{ .FeatureName | ToLower }}pb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ *timestamppb.Timestamp
+
+type {{ .FeatureName }} struct {
+{{- range .Fields }}
+	{{ .GoName }} {{ .GoType }}
+{{- end }}
+}
+
+type Get{{ .FeatureName }}Request struct {
+	Id {{ .IDType | ProtoGoType }}
+}
+
+type Get{{ .FeatureName }}sRequest struct {
+	Page     int32
+	PageSize int32
+	Sort     string
+	Order    string
+	Id       string
+}
+
+type Get{{ .FeatureName }}sResponse struct {
+	Rows       []*{{ .FeatureName }}
+	Total      int64
+	Page       int32
+	PageSize   int32
+	TotalPages int32
+}
+
+type Create{{ .FeatureName }}Request struct {
+	Data *{{ .FeatureName }}
+}
+
+type Create{{ .FeatureName }}Response struct{}
+
+type Update{{ .FeatureName }}Request struct {
+	Data *{{ .FeatureName }}
+}
+
+type Delete{{ .FeatureName }}Request struct {
+	Id {{ .IDType | ProtoGoType }}
+}
+
+type Delete{{ .FeatureName }}Response struct{}
+
+type {{ .FeatureName }}ServiceServer interface {
+	Get{{ .FeatureName }}(context.Context, *Get{{ .FeatureName }}Request) (*{{ .FeatureName }}, error)
+	Get{{ .FeatureName }}s(context.Context, *Get{{ .FeatureName }}sRequest) (*Get{{ .FeatureName }}sResponse, error)
+	Create{{ .FeatureName }}(context.Context, *Create{{ .FeatureName }}Request) (*Create{{ .FeatureName }}Response, error)
+	Update{{ .FeatureName }}(context.Context, *Update{{ .FeatureName }}Request) (*{{ .FeatureName }}, error)
+	Delete{{ .FeatureName }}(context.Context, *Delete{{ .FeatureName }}Request) (*Delete{{ .FeatureName }}Response, error)
+	mustEmbedUnimplemented{{ .FeatureName }}ServiceServer()
+}
+
+type Unimplemented{{ .FeatureName }}ServiceServer struct{}
+
+func (Unimplemented{{ .FeatureName }}ServiceServer) Get{{ .FeatureName }}(context.Context, *Get{{ .FeatureName }}Request) (*{{ .FeatureName }}, error) {
+	return nil, nil
+}
+func (Unimplemented{{ .FeatureName }}ServiceServer) Get{{ .FeatureName }}s(context.Context, *Get{{ .FeatureName }}sRequest) (*Get{{ .FeatureName }}sResponse, error) {
+	return nil, nil
+}
+func (Unimplemented{{ .FeatureName }}ServiceServer) Create{{ .FeatureName }}(context.Context, *Create{{ .FeatureName }}Request) (*Create{{ .FeatureName }}Response, error) {
+	return nil, nil
+}
+func (Unimplemented{{ .FeatureName }}ServiceServer) Update{{ .FeatureName }}(context.Context, *Update{{ .FeatureName }}Request) (*{{ .FeatureName }}, error) {
+	return nil, nil
+}
+func (Unimplemented{{ .FeatureName }}ServiceServer) Delete{{ .FeatureName }}(context.Context, *Delete{{ .FeatureName }}Request) (*Delete{{ .FeatureName }}Response, error) {
+	return nil, nil
+}
+func (Unimplemented{{ .FeatureName }}ServiceServer) mustEmbedUnimplemented{{ .FeatureName }}ServiceServer() {}
+
+func Register{{ .FeatureName }}ServiceServer(s grpc.ServiceRegistrar, srv {{ .FeatureName }}ServiceServer) {}
+`
+
+var GRPCStub = `
+package grpc
+
+type ServiceDesc struct {
+	ServiceName string
+	HandlerType interface{}
+}
+
+type ServiceRegistrar interface {
+	RegisterService(desc *ServiceDesc, impl interface{})
+}
+
+type ServerOption interface{}
+
+type Server struct{}
+
+func NewServer(opt ...ServerOption) *Server                       { return &Server{} }
+func (s *Server) RegisterService(sd *ServiceDesc, ss interface{}) {}
+func (s *Server) GracefulStop()                                   {}
+`
+
+var GRPCCodesStub = `
+package codes
+
+type Code uint32
+
+const (
+	OK              Code = 0
+	InvalidArgument Code = 3
+	NotFound        Code = 5
+	Unimplemented   Code = 12
+	Internal        Code = 13
+)
+`
+
+var GRPCStatusStub = `
+package status
+
+import "google.golang.org/grpc/codes"
+
+func Error(c codes.Code, msg string) error                       { return nil }
+func Errorf(c codes.Code, format string, a ...interface{}) error { return nil }
+`
+
+var TimestampStub = `
+package timestamppb
+
+import "time"
+
+type Timestamp struct {
+	Seconds int64
+	Nanos   int32
+}
+
+func New(t time.Time) *Timestamp       { return &Timestamp{} }
+func Now() *Timestamp                  { return &Timestamp{} }
+func (x *Timestamp) AsTime() time.Time { return time.Time{} }
+`
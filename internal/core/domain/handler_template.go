@@ -3,17 +3,36 @@ package domain
 type HandlerFlagDomain struct {
 	FeatureName string
 	ProjectName string
+	IDType      string
+	Validate    bool // whether the domain has a Validate method, called after parsing the body
+	Operations  []Operation
 }
 
-var HandlerTemplate = `
+// HandlerIDTemplate defines "parseID", which reads the "id" path parameter into id with the feature's ID type.
+var HandlerIDTemplate = `{{ define "parseID" }}{{ if eq .IDType "string" }}	id := c.Params("id")
+{{ else }}	parsedID, err := strconv.ParseUint(c.Params("id"), 10, 0)
+	if err != nil {
+		return utils.NewErrorResponse(c, "Invalid ID", err.Error())
+	}
+	id := {{ .IDType }}(parsedID)
+{{ end }}{{ end }}`
+
+// HandlerValidateTemplate defines "validate", which rejects a payload breaking the constraints of
+// the fields of the feature.
+var HandlerValidateTemplate = `{{ define "validate" }}	if err := payload.Validate(); err != nil {
+		return utils.NewErrorResponse(c, "Invalid request payload", err.Error())
+	}
+{{ end }}`
+
+var HandlerTemplate = HandlerIDTemplate + HandlerValidateTemplate + `
 package handlers
 
 import (
 	"context"
-	"strconv"
+	{{ if ne .IDType "string" }}"strconv"{{ end }}
 	"time"
 
-	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
 	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
 	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
 	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
@@ -28,7 +47,8 @@ type (
 		HandleUpdate{{ .FeatureName }}(c *fiber.Ctx) error
 		HandleCreate{{ .FeatureName }}(c *fiber.Ctx) error
 		HandleDelete{{ .FeatureName }}(c *fiber.Ctx) error
-	}
+{{ range .Operations }}		Handle{{ .Name }}(c *fiber.Ctx) error
+{{ end }}	}
 	{{ .FeatureName }}Impl struct {
 		{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
 	}
@@ -48,7 +68,7 @@ func (h *{{ .FeatureName }}Impl) HandleCreate{{ .FeatureName }}(c *fiber.Ctx) er
 	if err := c.BodyParser(&payload); err != nil {
 		return utils.NewErrorResponse(c, "Invalid request payload", err.Error())
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := ctx.Err(); err != nil {
 		return c.Context().Err()
@@ -59,50 +79,39 @@ func (h *{{ .FeatureName }}Impl) HandleCreate{{ .FeatureName }}(c *fiber.Ctx) er
 
 // HandleDelete{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
 func (h *{{ .FeatureName }}Impl) HandleDelete{{ .FeatureName }}(c *fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return utils.NewErrorResponse(c, "Invalid ID", err.Error())
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := ctx.Err(); err != nil {
 		return c.Context().Err()
 	}
-	res := h.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, uint(id))
+	res := h.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, id)
 	return c.JSON(res)
 }
 
 // HandleUpdate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
 func (h *{{ .FeatureName }}Impl) HandleUpdate{{ .FeatureName }}(c *fiber.Ctx) error {
 	var payload domain.{{ .FeatureName }}Domain
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return utils.NewErrorResponse(c, "Invalid ID", err.Error())
-	}
-	if err := c.BodyParser(&payload); err != nil {
+{{ template "parseID" . }}	if err := c.BodyParser(&payload); err != nil {
 		return utils.NewErrorResponse(c, "Invalid request payload", err.Error())
 	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	payload.ID = id
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := ctx.Err(); err != nil {
 		return c.Context().Err()
 	}
-	res := h.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, uint(id), payload)
+	res := h.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, payload)
 	return c.JSON(res)
 }
 
 // HandleGet{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
 func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}(c *fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return utils.NewErrorResponse(c, "Invalid ID", err.Error())
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := ctx.Err(); err != nil {
 		return c.Context().Err()
 	}
-	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, uint(id))
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, id)
 	return c.JSON(res)
 }
 
@@ -118,4 +127,23 @@ func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}s(c *fiber.Ctx) erro
 	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(paramCtx)
 	return c.JSON(res)
 }
-`
+` + HandlerOperationsTemplate
+
+// HandlerOperationsTemplate renders the Fiber handlers of the operations of a feature besides its
+// CRUD ones.
+var HandlerOperationsTemplate = `{{ range .Operations }}
+// Handle{{ .Name }} implements I{{ $.FeatureName }}Handler.
+func (h *{{ $.FeatureName }}Impl) Handle{{ .Name }}(c *fiber.Ctx) error {
+{{ if .HasID }}{{ template "parseID" $ }}{{ end }}{{ with .PayloadType $.FeatureName }}	var payload {{ . }}
+	if err := c.BodyParser(&payload); err != nil {
+		return utils.NewErrorResponse(c, "Invalid request payload", err.Error())
+	}
+{{ end }}{{ if and (eq .Body "domain") $.Validate }}{{ template "validate" $ }}{{ end }}	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := ctx.Err(); err != nil {
+		return c.Context().Err()
+	}
+	res := h.{{ $.FeatureName | ToLower }}Service.{{ .Name }}(ctx{{ .Args }})
+	return c.JSON(res)
+}
+{{ end }}`
@@ -0,0 +1,37 @@
+package domain
+
+type LayerStatus string
+
+const (
+	LayerPresent  LayerStatus = "ok"
+	LayerMissing  LayerStatus = "missing"
+	LayerOutdated LayerStatus = "outdated"
+)
+
+type InspectFlag struct {
+	Dir *string `json:"dir"`
+}
+
+type FeatureInventory struct {
+	Name   string
+	Layers map[string]LayerStatus
+	Files  map[string]string
+}
+
+type LintIssue struct {
+	File        string
+	Line        int
+	Column      int
+	Layer       string
+	Import      string
+	ImportLayer string
+	Error       string // a problem other than a forbidden import: the file does not parse, or the import does not match the module
+}
+
+type Diagnostic struct {
+	File    string
+	Line    int
+	Check   string
+	Message string
+	Fix     string
+}
@@ -0,0 +1,165 @@
+package domain
+
+// ManifestFileName is the file gohexa keeps at the project root to record what it generated.
+const ManifestFileName = "gohexa.json"
+
+// FeatureLayers lists the per-feature layers in the order they are usually generated.
+var FeatureLayers = []string{"model", "domain", "port", "repository", "service", "handler", "route", "app"}
+
+// FeatureLayerDirs maps FeatureLayers and the migrations to their directory in projects created
+// from the gohexa templates, relative to the project root. %s is the lower-case feature name.
+var FeatureLayerDirs = map[string]string{
+	"model":      "internal/adapters/database/models",
+	"domain":     "internal/core/domain/%s",
+	"port":       "internal/core/ports/%s",
+	"repository": "internal/adapters/repositories/%s",
+	"service":    "internal/core/services/%s",
+	"handler":    "internal/adapters/http/handlers/%s",
+	"route":      "internal/adapters/http/routers",
+	"app":        "internal/adapters/app",
+	"migration":  "migrations",
+}
+
+type Manifest struct {
+	Layout   *Layout           `json:"layout,omitempty"`
+	ORM      string            `json:"orm,omitempty"`      // persistence library the generators default -orm to
+	Database string            `json:"database,omitempty"` // database the generators default -db to
+	Features []ManifestFeature `json:"features"`
+}
+
+// Layout lists, relative to the module root, the directories holding each hexagonal layer.
+type Layout struct {
+	Domain   []string `json:"domain"`
+	Ports    []string `json:"ports"`
+	Services []string `json:"services"`
+	Adapters []string `json:"adapters"`
+}
+
+// DefaultLayout is the layout of projects created from the gohexa templates.
+var DefaultLayout = Layout{
+	Domain:   []string{"internal/core/domain"},
+	Ports:    []string{"internal/core/ports"},
+	Services: []string{"internal/core/services"},
+	Adapters: []string{"internal/adapters"},
+}
+
+// LayerDependencies lists which layers a package of each layer may import.
+// Packages outside the layout, such as pkg helpers, are not restricted.
+var LayerDependencies = map[string][]string{
+	"domain":   {"domain"},
+	"ports":    {"domain", "ports"},
+	"services": {"domain", "ports", "services"},
+	"adapters": {"domain", "ports", "services", "adapters"},
+}
+
+type ManifestFeature struct {
+	Name   string                   `json:"name"`
+	Layers map[string]ManifestLayer `json:"layers"`
+	Schema []ManifestColumn         `json:"schema,omitempty"` // columns of the fields as of the last migration
+}
+
+type ManifestLayer struct {
+	File     string `json:"file"`
+	Template string `json:"template"`
+	Checksum string `json:"checksum"`
+}
+
+// ManifestColumn is a column of the fields of a feature, as recorded by -generate migration to diff
+// the next migration against.
+type ManifestColumn struct {
+	Column     string `json:"column"`
+	Type       string `json:"type"` // Go type of the field
+	Index      string `json:"index,omitempty"`
+	References string `json:"references,omitempty"`
+	Nullable   bool   `json:"nullable,omitempty"`
+	Default    string `json:"default,omitempty"`
+}
+
+// Templates maps a template key, as recorded in the manifest, to its current source.
+var Templates = map[string]string{
+	"model":      ModelsTemplate,
+	"domain":     DomainTemplate,
+	"validation": ValidationTemplate,
+	"port":       PortsTemplate,
+	"repository": RepoTemplate,
+	"service":    ServiceTemplate,
+	"handler":    HandlerTemplate,
+	"route":      RouteTemplate,
+	"app":        AppTemplate,
+	"transactor": TransactorTemplate,
+
+	"domain.pure":     PureDomainTemplate,
+	"port.pure":       PurePortsTemplate,
+	"repository.pure": PureRepoTemplate,
+	"service.pure":    PureServiceTemplate,
+
+	"handler.gin": GinHandlerTemplate,
+	"route.gin":   GinRouteTemplate,
+	"router.gin":  GinRouterTemplate,
+	"app.gin":     GinAppTemplate,
+
+	"handler.echo": EchoHandlerTemplate,
+	"route.echo":   EchoRouteTemplate,
+	"router.echo":  EchoRouterTemplate,
+	"app.echo":     EchoAppTemplate,
+
+	"handler.stdlib": StdlibHandlerTemplate,
+	"route.stdlib":   StdlibRouteTemplate,
+	"router.stdlib":  StdlibRouterTemplate,
+	"app.stdlib":     StdlibAppTemplate,
+
+	"handler.chi": ChiHandlerTemplate,
+	"route.chi":   ChiRouteTemplate,
+	"router.chi":  ChiRouterTemplate,
+	"app.chi":     ChiAppTemplate,
+
+	"proto":          ProtoTemplate,
+	"grpc":           GRPCServerTemplate,
+	"grpc_app":       GRPCAppTemplate,
+	"grpc_container": GRPCContainerTemplate,
+
+	"grpc.connect":           ConnectServerTemplate,
+	"grpc_app.connect":       ConnectAppTemplate,
+	"grpc_container.connect": ConnectContainerTemplate,
+
+	"model.sqlx":           SqlxModelsTemplate,
+	"repository.sqlx":      SqlxRepoTemplate,
+	"repository.pure.sqlx": SqlxRepoTemplate,
+	"transactor.sqlx":      SqlxTransactorTemplate,
+
+	"model.pgx":           SqlxModelsTemplate,
+	"repository.pgx":      PgxRepoTemplate,
+	"repository.pure.pgx": PgxRepoTemplate,
+	"transactor.pgx":      PgxTransactorTemplate,
+
+	"model.sqlc":           SqlxModelsTemplate,
+	"repository.sqlc":      SqlcRepoTemplate,
+	"repository.pure.sqlc": SqlcRepoTemplate,
+	"transactor.sqlc":      SqlxTransactorTemplate,
+	"queries.sqlc":         SqlcQueriesTemplate,
+	"schema.sqlc":          SqlcSchemaTemplate,
+
+	"model.ent":           EntModelsTemplate,
+	"repository.ent":      EntRepoTemplate,
+	"repository.pure.ent": EntRepoTemplate,
+	"transactor.ent":      EntTransactorTemplate,
+	"ent_schema.ent":      EntSchemaTemplate,
+
+	"model.bun":           BunModelsTemplate,
+	"repository.bun":      BunRepoTemplate,
+	"repository.pure.bun": BunRepoTemplate,
+	"transactor.bun":      BunTransactorTemplate,
+
+	"model.mongo":           MongoModelsTemplate,
+	"repository.mongo":      MongoRepoTemplate,
+	"repository.pure.mongo": MongoRepoTemplate,
+	"transactor.mongo":      MongoTransactorTemplate,
+
+	"migration":       MigrationTemplate,
+	"migration_down":  MigrationDownTemplate,
+	"migration.goose": MigrationGooseTemplate,
+
+	"graphql":          GraphQLSchemaTemplate,
+	"resolver":         GraphQLResolverTemplate,
+	"resolver_convert": GraphQLConvertTemplate,
+}
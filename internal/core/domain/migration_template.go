@@ -0,0 +1,111 @@
+package domain
+
+type MigrationFlagDomain struct {
+	FeatureName string
+	Table       string // e.g. orders
+	UseUUID     bool
+	SoftDelete  bool // adds the deleted_at column of gorm.Model
+	Dialect     Dialect
+	Columns     []MigrationColumn
+	AlterUp     *MigrationChanges // set when the table exists, instead of CREATE TABLE
+	AlterDown   *MigrationChanges
+}
+
+// MigrationColumn is a column of the fields of a feature, typed for the dialect of the migration.
+type MigrationColumn struct {
+	Name       string // e.g. customer_id
+	Type       string // column type of the dialect, e.g. BIGINT
+	Index      string // one of FieldIndexes, if any
+	References string // table whose id the column references, if any
+	Nullable   bool
+	Default    string // SQL default of the field, if any
+	Fill       string // zero value of the Go type, which fills the existing rows a NOT NULL column without a default is added to
+}
+
+// MigrationChanges are the changes of a table migrating from one schema of a feature to another.
+type MigrationChanges struct {
+	Table   string
+	Dialect Dialect
+	Changes []MigrationChange
+}
+
+// MigrationChange is a statement of MigrationChanges. Kind is one of MigrationChangeKinds, in the
+// order of which the changes are made, so that foreign keys and indexes are dropped before their
+// columns and created after them.
+type MigrationChange struct {
+	Kind     string
+	Column   MigrationColumn
+	Previous string          // previous name of the column, for rename
+	From     MigrationColumn // column before the change, for alter and modify
+}
+
+// MigrationChangeKinds lists the kinds of MigrationChange in the order they are made. alter changes
+// the type of a column, modify whether it accepts NULL and its default.
+var MigrationChangeKinds = []string{"drop_foreign_key", "drop_index", "rename", "drop", "add", "alter", "modify", "add_index", "add_foreign_key"}
+
+// DestructiveChanges lists the kinds of MigrationChange that may lose data.
+var DestructiveChanges = []string{"drop", "alter"}
+
+// migrationStatements defines the "up" and "down" statements of a feature table, shared by the
+// golang-migrate and goose files. The foreign keys are table constraints, which SQLite only
+// accepts in CREATE TABLE.
+var migrationStatements = `{{ define "up" }}{{ if .AlterUp }}{{ template "alter" .AlterUp }}{{ else }}{{ if and .UseUUID .Dialect.UUIDSetup }}{{ .Dialect.UUIDSetup }}
+
+{{ end }}CREATE TABLE {{ .Table }} (
+    id {{ if .UseUUID }}{{ .Dialect.UUIDType }} PRIMARY KEY DEFAULT {{ .Dialect.UUIDDefault }}{{ else }}{{ .Dialect.SerialType }} PRIMARY KEY{{ end }},
+    created_at {{ index .Dialect.Types "time.Time" }} NOT NULL DEFAULT {{ .Dialect.Now }},
+    updated_at {{ index .Dialect.Types "time.Time" }} NOT NULL DEFAULT {{ .Dialect.Now }}{{ if .SoftDelete }},
+    deleted_at {{ index .Dialect.Types "time.Time" }} NULL{{ end }}{{ range .Columns }},
+    {{ .Name }} {{ .Type }} {{ if .Nullable }}NULL{{ else }}NOT NULL{{ end }}{{ with .Default }} DEFAULT {{ . }}{{ end }}{{ end }}{{ range .Columns }}{{ if .References }},
+    CONSTRAINT fk_{{ $.Table }}_{{ .Name }} FOREIGN KEY ({{ .Name }}) REFERENCES {{ .References }} (id){{ end }}{{ end }}
+);
+{{ if .SoftDelete }}
+CREATE INDEX idx_{{ .Table }}_deleted_at ON {{ .Table }} (deleted_at);
+{{ end }}{{ range .Columns }}{{ if .Index }}
+CREATE {{ if eq .Index "unique" }}UNIQUE {{ end }}INDEX idx_{{ $.Table }}_{{ .Name }} ON {{ $.Table }} ({{ .Name }});
+{{ end }}{{ end }}{{ end }}{{ end }}{{ define "down" }}{{ if .AlterDown }}{{ template "alter" .AlterDown }}{{ else }}DROP TABLE IF EXISTS {{ .Table }};
+{{ end }}{{ end }}` + migrationAlter
+
+// migrationAlter defines the "alter" statements of MigrationChanges. SQLite cannot change the type,
+// the NULL constraint, the default or the foreign keys of existing columns, which need the table to
+// be rebuilt by hand. MySQL restates the whole column with MODIFY COLUMN, so a retyped column has no
+// modify change and its alter warns when it no longer accepts NULL. A NOT NULL column added without
+// a default, also by a down migration that restores a dropped column, is filled with its Fill or
+// fails on a table with rows.
+var migrationAlter = `{{ define "alter" }}{{ $mysql := eq .Dialect.Name "mysql" }}{{ $sqlite := eq .Dialect.Name "sqlite" }}{{ range .Changes }}{{ $fk := printf "fk_%s_%s" $.Table .Column.Name }}{{ $idx := printf "idx_%s_%s" $.Table .Column.Name }}
+{{- if eq .Kind "drop_foreign_key" }}{{ if $sqlite }}-- SQLite cannot drop {{ $fk }} from {{ $.Table }}: rebuild the table without it.{{ else }}ALTER TABLE {{ $.Table }} DROP {{ if $mysql }}FOREIGN KEY{{ else }}CONSTRAINT{{ end }} {{ $fk }};{{ end }}
+{{- else if eq .Kind "drop_index" }}DROP INDEX {{ $idx }}{{ if $mysql }} ON {{ $.Table }}{{ end }};
+{{- else if eq .Kind "rename" }}ALTER TABLE {{ $.Table }} RENAME COLUMN {{ .Previous }} TO {{ .Column.Name }};
+{{- else if eq .Kind "drop" }}-- WARNING: drops {{ .Column.Name }} and its data.
+ALTER TABLE {{ $.Table }} DROP COLUMN {{ .Column.Name }};
+{{- else if eq .Kind "add" }}{{ if not (or .Column.Nullable .Column.Default) }}{{ if .Column.Fill }}-- WARNING: fills {{ .Column.Name }} of the existing rows with {{ .Column.Fill }}.
+{{ else }}-- WARNING: fails while {{ $.Table }} has rows, as {{ .Column.Name }} is NOT NULL without a default.
+{{ end }}{{ end }}ALTER TABLE {{ $.Table }} ADD COLUMN {{ .Column.Name }} {{ .Column.Type }} {{ if .Column.Nullable }}NULL{{ else }}NOT NULL{{ end }}{{ with or .Column.Default .Column.Fill }} DEFAULT {{ . }}{{ end }};
+{{- else if eq .Kind "alter" }}-- WARNING: converts the data of {{ .Column.Name }} to {{ .Column.Type }}.
+{{ if and $mysql .From.Nullable (not .Column.Nullable) }}-- WARNING: fails while {{ .Column.Name }} holds NULL values.
+{{ end }}{{ if $sqlite }}-- SQLite cannot change the type of {{ .Column.Name }}: rebuild the table with it.{{ else if $mysql }}ALTER TABLE {{ $.Table }} MODIFY COLUMN {{ .Column.Name }} {{ .Column.Type }} {{ if .Column.Nullable }}NULL{{ else }}NOT NULL{{ end }}{{ with .Column.Default }} DEFAULT {{ . }}{{ end }};{{ else }}ALTER TABLE {{ $.Table }} ALTER COLUMN {{ .Column.Name }} TYPE {{ .Column.Type }};{{ end }}
+{{- else if eq .Kind "modify" }}{{ $null := ne .From.Nullable .Column.Nullable }}{{ $default := ne .From.Default .Column.Default }}{{ if and $null (not .Column.Nullable) (not $sqlite) }}-- WARNING: fails while {{ .Column.Name }} holds NULL values.
+{{ end }}{{ if $sqlite }}-- SQLite cannot change the NULL constraint or the default of {{ .Column.Name }}: rebuild the table with it.{{ else if $mysql }}ALTER TABLE {{ $.Table }} MODIFY COLUMN {{ .Column.Name }} {{ .Column.Type }} {{ if .Column.Nullable }}NULL{{ else }}NOT NULL{{ end }}{{ with .Column.Default }} DEFAULT {{ . }}{{ end }};
+{{- else }}{{ if $null }}ALTER TABLE {{ $.Table }} ALTER COLUMN {{ .Column.Name }} {{ if .Column.Nullable }}DROP{{ else }}SET{{ end }} NOT NULL;{{ end }}{{ if and $null $default }}
+{{ end }}{{ if $default }}ALTER TABLE {{ $.Table }} ALTER COLUMN {{ .Column.Name }} {{ with .Column.Default }}SET DEFAULT {{ . }}{{ else }}DROP DEFAULT{{ end }};{{ end }}{{ end }}
+{{- else if eq .Kind "add_index" }}CREATE {{ if eq .Column.Index "unique" }}UNIQUE {{ end }}INDEX {{ $idx }} ON {{ $.Table }} ({{ .Column.Name }});
+{{- else if eq .Kind "add_foreign_key" }}{{ if $sqlite }}-- SQLite cannot add {{ $fk }} to {{ $.Table }}: rebuild the table with it.{{ else }}ALTER TABLE {{ $.Table }} ADD CONSTRAINT {{ $fk }} FOREIGN KEY ({{ .Column.Name }}) REFERENCES {{ .Column.References }} (id);{{ end }}
+{{- end }}
+{{ end }}{{ end }}`
+
+// MigrationTemplate renders the up file of golang-migrate, which creates the table of a feature with
+// its indexes and foreign keys, or alters it when the feature has a recorded schema.
+var MigrationTemplate = migrationStatements + `-- {{ if .AlterUp }}Alters{{ else }}Creates{{ end }} the {{ .Table }} table of the {{ .FeatureName }} feature.
+{{ template "up" . }}`
+
+// MigrationDownTemplate renders the down file of golang-migrate, which drops the table and with it
+// its indexes, or reverts the changes of the up file.
+var MigrationDownTemplate = migrationStatements + `-- {{ if .AlterDown }}Reverts the changes to{{ else }}Drops{{ end }} the {{ .Table }} table of the {{ .FeatureName }} feature.
+{{ template "down" . }}`
+
+// MigrationGooseTemplate renders the goose file, with both the up and the down statements.
+var MigrationGooseTemplate = migrationStatements + `-- {{ if .AlterUp }}Alters{{ else }}Creates{{ end }} the {{ .Table }} table of the {{ .FeatureName }} feature.
+-- +goose Up
+{{ template "up" . }}
+-- +goose Down
+{{ template "down" . }}`
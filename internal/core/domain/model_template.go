@@ -4,6 +4,9 @@ type ModelFlagDomain struct {
 	FeatureName string
 	ProjectName string
 	UseUUID     bool
+	Fields      []Field
+	Dialect     Dialect
+	Table       string // e.g. orders
 }
 
 var ModelsTemplate = `
@@ -17,13 +20,14 @@ import (
 
 type {{ .FeatureName }} struct {
 	gorm.Model
-	{{ if .UseUUID }}ID                 string         ` + "`gorm:\"type:uuid;primaryKey;default:uuid_generate_v4()\" json:\"id\"`" + `{{ else }}ID                 uint           ` + "`gorm:\"primaryKey;autoIncrement\" json:\"id\"`" + `{{ end }}
+	{{ if .UseUUID }}ID                 string         ` + "`gorm:\"type:{{ .Dialect.UUIDType | ToLower }};primaryKey;default:{{ .Dialect.UUIDDefault }}\" json:\"id\"`" + `{{ else }}ID                 uint           ` + "`gorm:\"primaryKey;autoIncrement\" json:\"id\"`" + `{{ end }}
 	CreatedAt          time.Time      ` + "`json:\"created_at\" gorm:\"autoCreateTime\"`" + `
 	UpdatedAt          time.Time      ` + "`json:\"updated_at\" gorm:\"autoUpdateTime\"`" + `
 	DeletedAt          gorm.DeletedAt ` + "`gorm:\"index\" json:\"deleted_at,omitempty\"`" + `
-}
+{{ range .Fields }}	{{ .Name }} {{ .GoType }} ` + "`json:\"{{ .Column }}\"`" + `
+{{ end }}}
 
-var TN{{ .FeatureName }} = "{{ .FeatureName | ToLower }}s"
+var TN{{ .FeatureName }} = "{{ .Table }}"
 
 func (st *{{ .FeatureName }}) TableName() string {
 	return TN{{ .FeatureName }}
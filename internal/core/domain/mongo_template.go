@@ -0,0 +1,385 @@
+package domain
+
+// MongoModelsTemplate renders the document of a feature for -db mongo. The ID is an ObjectID, or a
+// UUID string with -uuid.
+var MongoModelsTemplate = `
+package models
+
+import (
+	"time"
+{{ if not .UseUUID }}
+	"go.mongodb.org/mongo-driver/bson/primitive"
+{{ end }})
+
+type {{ .FeatureName }} struct {
+	{{ if .UseUUID }}ID        string             ` + "`bson:\"_id\" json:\"id\"`" + `{{ else }}ID        primitive.ObjectID ` + "`bson:\"_id,omitempty\" json:\"id\"`" + `{{ end }}
+	CreatedAt time.Time ` + "`bson:\"created_at\" json:\"created_at\"`" + `
+	UpdatedAt time.Time ` + "`bson:\"updated_at\" json:\"updated_at\"`" + `
+{{ range .Fields }}	{{ .Name }} {{ .GoType }} ` + "`bson:\"{{ .Column }}\" json:\"{{ .Column }}\"`" + `
+{{ end }}}
+
+var CN{{ .FeatureName }} = "{{ .Table }}"
+
+func (st *{{ .FeatureName }}) CollectionName() string {
+	return CN{{ .FeatureName }}
+}
+`
+
+// MongoTransactorTemplate renders the transactor for -db mongo. Transactions run on a session: the
+// context passed to tFunc carries it, so the collection operations made with that context join the
+// transaction.
+var MongoTransactorTemplate = `
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type TransactorImpl struct {
+	client *mongo.Client
+}
+
+// BeginTransaction implements IDatabaseTransactor.
+// It starts a session with a transaction. Operations join it with mongo.NewSessionContext(ctx, session).
+func (d *TransactorImpl) BeginTransaction() (mongo.Session, error) {
+	session, err := d.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start session: %w", err)
+	}
+	if err := session.StartTransaction(); err != nil {
+		session.EndSession(context.Background())
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return session, nil
+}
+
+// RollbackTransaction aborts the transaction of the session if it was started and ends the session.
+func (d *TransactorImpl) RollbackTransaction(session mongo.Session) error {
+	if session == nil {
+		return nil // No transaction to rollback
+	}
+	defer session.EndSession(context.Background())
+	if err := session.AbortTransaction(context.Background()); err != nil {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// WithinTransaction implements IDatabaseTransactor.
+// WithinTransaction runs the provided function within a transaction context.
+// The transaction is committed if the function completes successfully, or aborted if an error occurs.
+// The driver retries the whole function on transient transaction errors, so it may run more than once.
+func (d *TransactorImpl) WithinTransaction(ctx context.Context, tFunc func(ctx context.Context) error) error {
+	session, err := d.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	// Run the callback function with the session context
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, tFunc(sc)
+	})
+	return err
+}
+
+// WithTransactionContextTimeout executes a function within a transaction with a specified context timeout.
+// The transaction is committed if successful, or aborted if an error occurs or the context times out.
+func (d *TransactorImpl) WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) error {
+	// Create a new context with timeout
+	transactionCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return d.WithinTransaction(transactionCtx, tFunc)
+}
+
+type IDatabaseTransactor interface {
+	WithinTransaction(context.Context, func(ctx context.Context) error) error
+	WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) error
+	BeginTransaction() (mongo.Session, error)
+	RollbackTransaction(session mongo.Session) error
+}
+
+func NewTransactorRepo(db *mongo.Database) IDatabaseTransactor {
+	return &TransactorImpl{client: db.Client()}
+}
+`
+
+// MongoRepoTemplate renders the repository for -db mongo over the feature's *mongo.Collection. It
+// serves both the default and the -pure ports; with PureDomain the mappers also convert the ObjectID
+// to the string ID of the domain entity.
+var MongoRepoTemplate = `
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+{{ if .PureDomain }}	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+{{ end }}	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+{{ if .UseUUID }}	"github.com/google/uuid"
+{{ end }}	"go.mongodb.org/mongo-driver/bson"
+{{ if not .UseUUID }}	"go.mongodb.org/mongo-driver/bson/primitive"
+{{ end }}	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type {{ .FeatureName }}Impl struct {
+	collection *mongo.Collection
+}
+
+func New{{ .FeatureName }}Repository(db *mongo.Database) ports.I{{ .FeatureName }}Repository {
+	return &{{ .FeatureName }}Impl{collection: db.Collection(models.CN{{ .FeatureName }})}
+}
+
+// {{ .FeatureName | ToLowerCamel }}ID converts an ID of the port to the _id of the documents.
+func {{ .FeatureName | ToLowerCamel }}ID(id {{ .IDType }}) ({{ if .UseUUID }}string{{ else }}primitive.ObjectID{{ end }}, error) {
+{{ if .UseUUID }}	return id, nil
+{{ else }}	return primitive.ObjectIDFromHex(id)
+{{ end }}}
+{{ if .PureDomain }}
+// To{{ .FeatureName }}Domain maps the document to the domain entity.
+func To{{ .FeatureName }}Domain(data *models.{{ .FeatureName }}) domain.{{ .FeatureName }}Domain {
+	return domain.{{ .FeatureName }}Domain{
+		ID:        data.ID{{ if not .UseUUID }}.Hex(){{ end }},
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
+}
+
+// To{{ .FeatureName }}Model maps the domain entity to the document.{{ if not .UseUUID }} An ID that is not an ObjectID
+// is left zero.{{ end }}
+func To{{ .FeatureName }}Model(data *domain.{{ .FeatureName }}Domain) *models.{{ .FeatureName }} {
+{{ if not .UseUUID }}	id, _ := {{ .FeatureName | ToLowerCamel }}ID(data.ID)
+{{ end }}	return &models.{{ .FeatureName }}{
+		ID:        {{ if .UseUUID }}data.ID{{ else }}id{{ end }},
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
+}
+{{ end }}
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.ID = {{ if .UseUUID }}uuid.NewString(){{ else }}primitive.NewObjectID(){{ end }}
+	data.CreatedAt = time.Now()
+	data.UpdatedAt = data.CreatedAt
+	if _, err := o.collection.InsertOne(ctx, data); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
+	key, err := {{ .FeatureName | ToLowerCamel }}ID(id)
+	if err != nil {
+		return err
+	}
+	if _, err := o.collection.DeleteOne(ctx, bson.M{"_id": key}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*{{ template "entity" . }}, error) {
+	key, err := {{ .FeatureName | ToLowerCamel }}ID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var data models.{{ .FeatureName }}
+	if err := o.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&data); err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	res := To{{ .FeatureName }}Domain(&data)
+	return &res, nil
+{{ else }}	return &data, nil
+{{ end }}}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]{{ template "entity" . }}], error) {
+	p := pagination.GetFilters[filters.{{ .FeatureName }}Filter](ctx)
+	fp := p.Filters
+
+	filter := bson.M{}
+	if fp.ID != "" {
+		key, err := {{ .FeatureName | ToLowerCamel }}ID(fp.ID)
+		if err != nil {
+			return nil, err
+		}
+		filter["_id"] = key
+	}
+
+	// Sort on the field of the sort parameter, by default the latest updated come first.
+	sort := bson.D{bson.E{Key: "updated_at", Value: -1}}
+	if p.Sort != "" {
+		key, direction := p.Sort, -1
+		if key == "id" {
+			key = "_id"
+		}
+		if strings.EqualFold(p.Order, "asc") {
+			direction = 1
+		}
+		sort = bson.D{bson.E{Key: key, Value: direction}}
+	}
+
+	total, err := o.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := p.Page, p.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	opts := options.Find().SetSort(sort).SetSkip(int64((page - 1) * pageSize)).SetLimit(int64(pageSize))
+	cursor, err := o.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]models.{{ .FeatureName }}, 0, pageSize)
+	if err := cursor.All(ctx, &data); err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	rows := make([]domain.{{ .FeatureName }}Domain, 0, len(data))
+	for i := range data {
+		rows = append(rows, To{{ .FeatureName }}Domain(&data[i]))
+	}
+{{ end }}	return &pagination.Pagination[[]{{ template "entity" . }}]{
+		Rows:       {{ if .PureDomain }}rows{{ else }}data{{ end }},
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.UpdatedAt = time.Now()
+	update := bson.M{"$set": bson.M{
+		"updated_at": data.UpdatedAt,
+{{ range .Fields }}		"{{ .Column }}": data.{{ .Name }},
+{{ end }}	}}
+	if _, err := o.collection.UpdateByID(ctx, data.ID, update); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+` + repoModeTemplate
+
+// MongoStubs are the packages of the MongoDB driver the model, the repository and the transactor
+// import. They are only used to verify the feature.
+var MongoStubs = map[string]string{
+	"github.com/google/uuid": UUIDStub,
+	"go.mongodb.org/mongo-driver/bson": `
+package bson
+
+type M map[string]interface{}
+
+type E struct {
+	Key   string
+	Value interface{}
+}
+
+type D []E
+`,
+	"go.mongodb.org/mongo-driver/bson/primitive": `
+package primitive
+
+type ObjectID [12]byte
+
+func NewObjectID() ObjectID                        { return ObjectID{} }
+func ObjectIDFromHex(s string) (ObjectID, error)   { return ObjectID{}, nil }
+func (id ObjectID) Hex() string                    { return "" }
+`,
+	"go.mongodb.org/mongo-driver/mongo/options": `
+package options
+
+type FindOptions struct{}
+
+func Find() *FindOptions                                  { return &FindOptions{} }
+func (f *FindOptions) SetSort(sort interface{}) *FindOptions { return f }
+func (f *FindOptions) SetSkip(i int64) *FindOptions          { return f }
+func (f *FindOptions) SetLimit(i int64) *FindOptions         { return f }
+`,
+	"go.mongodb.org/mongo-driver/mongo": `
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type Client struct{}
+
+func (c *Client) StartSession() (Session, error)   { return nil, nil }
+func (c *Client) Database(name string) *Database { return &Database{} }
+
+type Database struct{}
+
+func (db *Database) Client() *Client                       { return &Client{} }
+func (db *Database) Collection(name string) *Collection    { return &Collection{} }
+
+type Session interface {
+	StartTransaction() error
+	AbortTransaction(ctx context.Context) error
+	CommitTransaction(ctx context.Context) error
+	WithTransaction(ctx context.Context, fn func(ctx SessionContext) (interface{}, error)) (interface{}, error)
+	EndSession(ctx context.Context)
+}
+
+type SessionContext interface {
+	context.Context
+	Session
+}
+
+type Collection struct{}
+
+type InsertOneResult struct{ InsertedID interface{} }
+type DeleteResult struct{ DeletedCount int64 }
+type UpdateResult struct{ MatchedCount, ModifiedCount int64 }
+
+type SingleResult struct{}
+
+func (r *SingleResult) Decode(v interface{}) error { return nil }
+
+type Cursor struct{}
+
+func (c *Cursor) All(ctx context.Context, results interface{}) error { return nil }
+
+func (c *Collection) InsertOne(ctx context.Context, document interface{}) (*InsertOneResult, error) {
+	return &InsertOneResult{}, nil
+}
+func (c *Collection) DeleteOne(ctx context.Context, filter interface{}) (*DeleteResult, error) {
+	return &DeleteResult{}, nil
+}
+func (c *Collection) FindOne(ctx context.Context, filter interface{}) *SingleResult { return &SingleResult{} }
+func (c *Collection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*Cursor, error) {
+	return &Cursor{}, nil
+}
+func (c *Collection) CountDocuments(ctx context.Context, filter interface{}) (int64, error) { return 0, nil }
+func (c *Collection) UpdateByID(ctx context.Context, id interface{}, update interface{}) (*UpdateResult, error) {
+	return &UpdateResult{}, nil
+}
+`,
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "strings"
+
+// Operation is an endpoint of a feature besides its CRUD ones, e.g. POST /orders/{id}/cancel, with a
+// handler, a route and a service method left to implement.
+type Operation struct {
+	Name    string `json:"name"`   // method of the service and, after Handle, of the handler, e.g. CancelOrder
+	Method  string `json:"method"` // e.g. POST
+	Path    string `json:"path"`   // below the path of the feature, with {id} for its ID, e.g. /{id}/cancel
+	Summary string `json:"summary,omitempty"`
+	Body    string `json:"body,omitempty"` // "domain" for the domain of the feature, "map" for another JSON object
+}
+
+// HasID reports whether the path of the operation has the ID of the feature.
+func (o Operation) HasID() bool {
+	return strings.Contains(o.Path, "{id}")
+}
+
+// ColonPath returns the path in the syntax of Fiber, Gin and Echo, e.g. /:id/cancel.
+func (o Operation) ColonPath() string {
+	return strings.ReplaceAll(o.Path, "{id}", ":id")
+}
+
+// Func returns the method in the case of the route functions of Fiber and chi, e.g. Post.
+func (o Operation) Func() string {
+	return o.Method[:1] + strings.ToLower(o.Method[1:])
+}
+
+// Params returns the parameters of the service method after its context, e.g. ", id uint, payload
+// domain.OrderDomain".
+func (o Operation) Params(idType, featureName string) string {
+	var params string
+	if o.HasID() {
+		params += ", id " + idType
+	}
+	if payload := o.PayloadType(featureName); payload != "" {
+		params += ", payload " + payload
+	}
+	return params
+}
+
+// Args returns the arguments of the call of the service method after its context, e.g. ", id, payload".
+func (o Operation) Args() string {
+	var args string
+	if o.HasID() {
+		args += ", id"
+	}
+	if o.Body != "" {
+		args += ", payload"
+	}
+	return args
+}
+
+// PayloadType returns the Go type of the request body, if any.
+func (o Operation) PayloadType(featureName string) string {
+	switch o.Body {
+	case "domain":
+		return "domain." + featureName + "Domain"
+	case "map":
+		return "map[string]any"
+	}
+	return ""
+}
+
+// OperationMethods lists the HTTP methods of operations.
+var OperationMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
@@ -0,0 +1,443 @@
+package domain
+
+// PgxTransactorTemplate renders the transactor for -orm pgx. It keeps the pgx.Tx in the context and
+// has the same WithinTransaction contract as TransactorTemplate.
+var PgxTransactorTemplate = `
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Idea https://www.kaznacheev.me/posts/en/clean-transactions-in-hexagon/
+type txKey struct{}
+
+// DBTX is implemented by both *pgxpool.Pool and pgx.Tx, so repositories run the same queries
+// inside and outside of a transaction.
+type DBTX interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+}
+
+// injectTx injects the transaction into the context
+func InjectTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// extractTx extracts the transaction from the context
+func ExtractTx(ctx context.Context) pgx.Tx {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func HelperExtractTx(ctx context.Context, db *pgxpool.Pool) DBTX {
+	if tx := ExtractTx(ctx); tx != nil {
+		return tx
+	}
+	return db
+}
+
+type TransactorImpl struct {
+	db *pgxpool.Pool
+}
+
+// BeginTransaction implements IDatabaseTransactor.
+func (d *TransactorImpl) BeginTransaction() (pgx.Tx, error) {
+	tx, err := d.db.Begin(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// RollbackTransaction rolls back the transaction if it was started and returns any error encountered.
+// A transaction that was already committed or rolled back is not an error.
+func (d *TransactorImpl) RollbackTransaction(tx pgx.Tx) error {
+	if tx == nil {
+		return nil // No transaction to rollback
+	}
+	if err := tx.Rollback(context.Background()); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// WithinTransaction implements IDatabaseTransactor.
+// WithinTransaction runs the provided function within a transaction context.
+// The transaction is automatically committed if the function completes successfully, or rolled back if an error occurs.
+func (d *TransactorImpl) WithinTransaction(ctx context.Context, tFunc func(ctx context.Context) error) (err error) {
+	// begin transaction
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	// Ensure that the transaction is finalized properly
+	defer func() {
+		if r := recover(); r != nil {
+			_ = d.RollbackTransaction(tx)
+			panic(r) // Re-panic after rollback
+		} else if err != nil {
+			_ = d.RollbackTransaction(tx)
+		} else if commitErr := tx.Commit(ctx); commitErr != nil {
+			log.Printf("failed to commit transaction: %v", commitErr)
+			err = commitErr
+		}
+	}()
+
+	// Run the callback function with the transaction context
+	return tFunc(InjectTx(ctx, tx))
+}
+
+// WithTransactionContextTimeout executes a function within a transaction with a specified context timeout.
+// The transaction is committed if successful, or rolled back if an error occurs or the context times out.
+func (d *TransactorImpl) WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) (err error) {
+	// Create a new context with timeout
+	transactionCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Start a new transaction
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure that the transaction is finalized properly
+	defer func() {
+		if err == nil {
+			err = transactionCtx.Err() // Rollback if the context is done (timeout or cancel)
+		}
+		if err != nil {
+			if rollbackErr := d.RollbackTransaction(tx); rollbackErr != nil {
+				log.Printf("failed to rollback transaction: %v", rollbackErr)
+			}
+		} else if commitErr := tx.Commit(transactionCtx); commitErr != nil {
+			log.Printf("failed to commit transaction: %v", commitErr)
+			err = commitErr
+		}
+	}()
+
+	// Run the callback function with the transaction context
+	return tFunc(InjectTx(transactionCtx, tx))
+}
+
+type IDatabaseTransactor interface {
+	WithinTransaction(context.Context, func(ctx context.Context) error) error
+	WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) error
+	BeginTransaction() (pgx.Tx, error)
+	RollbackTransaction(tx pgx.Tx) error
+}
+
+func NewTransactorRepo(db *pgxpool.Pool) IDatabaseTransactor {
+	return &TransactorImpl{db: db}
+}
+`
+
+// PgxRepoTemplate renders the repository for -orm pgx, with the SQL written out from the fields of
+// the feature and rows scanned by the db tags of the sqlx model. Besides the port it has bulk
+// inserts over a pgx.Batch and the COPY protocol. It serves both the default and the -pure ports.
+var PgxRepoTemplate = `
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+{{ if .PureDomain }}	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+{{ end }}	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Queries of the models.TN{{ .FeatureName }} table. Parameters are bound by name with pgx.NamedArgs.
+var (
+	select{{ .FeatureName }}Query = "SELECT id, created_at, updated_at{{ range .Fields }}, {{ .Column }}{{ end }} FROM " + models.TN{{ .FeatureName }}
+	count{{ .FeatureName }}Query  = "SELECT COUNT(*) FROM " + models.TN{{ .FeatureName }}
+	insert{{ .FeatureName }}Query = "INSERT INTO " + models.TN{{ .FeatureName }} + " (created_at, updated_at{{ range .Fields }}, {{ .Column }}{{ end }}) VALUES (@created_at, @updated_at{{ range .Fields }}, @{{ .Column }}{{ end }}) RETURNING id"
+	update{{ .FeatureName }}Query = "UPDATE " + models.TN{{ .FeatureName }} + " SET updated_at = @updated_at{{ range .Fields }}, {{ .Column }} = @{{ .Column }}{{ end }} WHERE id = @id"
+	delete{{ .FeatureName }}Query = "DELETE FROM " + models.TN{{ .FeatureName }} + " WHERE id = @id"
+
+	// {{ .FeatureName | ToLowerCamel }}CopyColumns are the columns written by Copy{{ .FeatureName }}s, in the order of its rows.
+	{{ .FeatureName | ToLowerCamel }}CopyColumns = []string{"created_at", "updated_at"{{ range .Fields }}, "{{ .Column }}"{{ end }}}
+)
+
+// {{ .FeatureName | ToLowerCamel }}Args binds the columns of the model to the named parameters of the queries.
+func {{ .FeatureName | ToLowerCamel }}Args(data *models.{{ .FeatureName }}) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":         data.ID,
+		"created_at": data.CreatedAt,
+		"updated_at": data.UpdatedAt,
+{{ range .Fields }}		"{{ .Column }}": data.{{ .Name }},
+{{ end }}	}
+}
+
+type {{ .FeatureName }}Impl struct {
+	db *pgxpool.Pool
+}
+
+func New{{ .FeatureName }}Repository(db *pgxpool.Pool) ports.I{{ .FeatureName }}Repository {
+	return &{{ .FeatureName }}Impl{db: db}
+}
+{{ template "mappers" . }}
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.CreatedAt = time.Now()
+	data.UpdatedAt = data.CreatedAt
+	if err := tx.QueryRow(ctx, insert{{ .FeatureName }}Query, {{ .FeatureName | ToLowerCamel }}Args(data)).Scan(&data.ID); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	if _, err := tx.Exec(ctx, delete{{ .FeatureName }}Query, pgx.NamedArgs{"id": id}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*{{ template "entity" . }}, error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	rows, err := tx.Query(ctx, select{{ .FeatureName }}Query+" WHERE id = @id", pgx.NamedArgs{"id": id})
+	if err != nil {
+		return nil, err
+	}
+	data, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[models.{{ .FeatureName }}])
+	if err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	res := To{{ .FeatureName }}Domain(&data)
+	return &res, nil
+{{ else }}	return &data, nil
+{{ end }}}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]{{ template "entity" . }}], error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	p := pagination.GetFilters[filters.{{ .FeatureName }}Filter](ctx)
+	fp := p.Filters
+
+	orderBy := pagination.NewOrderBy(pagination.SortParams{
+		Sort:           p.Sort,
+		Order:          p.Order,
+		DefaultOrderBy: "updated_at DESC",
+	})
+	page, pageSize := p.Page, p.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	where, args := "", pgx.NamedArgs{"limit": pageSize, "offset": (page - 1) * pageSize}
+	if fp.ID != "" {
+		where = " WHERE id::text = @id"
+		args["id"] = fp.ID
+	}
+
+	var total int64
+	if err := tx.QueryRow(ctx, count{{ .FeatureName }}Query+where, args).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, select{{ .FeatureName }}Query+where+" ORDER BY "+orderBy+" LIMIT @limit OFFSET @offset", args)
+	if err != nil {
+		return nil, err
+	}
+	data, err := pgx.CollectRows(rows, pgx.RowToStructByName[models.{{ .FeatureName }}])
+	if err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	res := make([]domain.{{ .FeatureName }}Domain, 0, len(data))
+	for i := range data {
+		res = append(res, To{{ .FeatureName }}Domain(&data[i]))
+	}
+{{ end }}	return &pagination.Pagination[[]{{ template "entity" . }}]{
+		Rows:       {{ if .PureDomain }}res{{ else }}data{{ end }},
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.UpdatedAt = time.Now()
+	if _, err := tx.Exec(ctx, update{{ .FeatureName }}Query, {{ .FeatureName | ToLowerCamel }}Args(data)); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+
+// Create{{ .FeatureName }}Batch inserts the payloads in a single round trip and sets their generated IDs.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}Batch(ctx context.Context, payloads []*{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	now := time.Now()
+	batch := &pgx.Batch{}
+	for i := range payloads {
+		payload := payloads[i]
+		data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+		data.CreatedAt = now
+		data.UpdatedAt = now
+		batch.Queue(insert{{ .FeatureName }}Query, {{ .FeatureName | ToLowerCamel }}Args(data)).QueryRow(func(row pgx.Row) error {
+{{ if .PureDomain }}			if err := row.Scan(&data.ID); err != nil {
+				return err
+			}
+			*payload = To{{ .FeatureName }}Domain(data)
+			return nil
+{{ else }}			return row.Scan(&data.ID)
+{{ end }}		})
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// Copy{{ .FeatureName }}s bulk loads the payloads with the COPY protocol and returns the number of rows
+// copied. It is the fastest way to insert many rows, but does not return the generated IDs.
+func (o *{{ .FeatureName }}Impl) Copy{{ .FeatureName }}s(ctx context.Context, payloads []{{ template "entity" . }}) (int64, error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+	now := time.Now()
+	return tx.CopyFrom(ctx, pgx.Identifier{models.TN{{ .FeatureName }}}, {{ .FeatureName | ToLowerCamel }}CopyColumns, pgx.CopyFromSlice(len(payloads), func(i int) ([]any, error) {
+		return []any{now, now{{ range .Fields }}, payloads[i].{{ .Name }}{{ end }}}, nil
+	}))
+}
+` + repoModeTemplate
+
+var PgxStub = `
+package pgx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var ErrTxClosed = errors.New("tx is closed")
+
+type Row interface {
+	Scan(dest ...any) error
+}
+
+type Rows interface {
+	Close()
+	Err() error
+	Next() bool
+	Scan(dest ...any) error
+	Values() ([]any, error)
+}
+
+type CollectableRow interface {
+	Scan(dest ...any) error
+	Values() ([]any, error)
+}
+
+type RowToFunc[T any] func(row CollectableRow) (T, error)
+
+func RowToStructByName[T any](row CollectableRow) (T, error)            { var t T; return t, nil }
+func CollectRows[T any](rows Rows, fn RowToFunc[T]) ([]T, error)        { return nil, nil }
+func CollectOneRow[T any](rows Rows, fn RowToFunc[T]) (T, error)        { var t T; return t, nil }
+
+type NamedArgs map[string]any
+
+type Identifier []string
+
+type CopyFromSource interface {
+	Next() bool
+	Values() ([]any, error)
+	Err() error
+}
+
+func CopyFromSlice(length int, next func(int) ([]any, error)) CopyFromSource { return nil }
+
+type QueuedQuery struct{}
+
+func (qq *QueuedQuery) QueryRow(fn func(row Row) error) {}
+func (qq *QueuedQuery) Exec(fn func(ct pgconn.CommandTag) error) {}
+
+type Batch struct{}
+
+func (b *Batch) Queue(query string, arguments ...any) *QueuedQuery { return &QueuedQuery{} }
+func (b *Batch) Len() int                                          { return 0 }
+
+type BatchResults interface {
+	Exec() (pgconn.CommandTag, error)
+	Query() (Rows, error)
+	QueryRow() Row
+	Close() error
+}
+
+type Tx interface {
+	Begin(ctx context.Context) (Tx, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+	CopyFrom(ctx context.Context, tableName Identifier, columnNames []string, rowSrc CopyFromSource) (int64, error)
+	SendBatch(ctx context.Context, b *Batch) BatchResults
+	Exec(ctx context.Context, sql string, arguments ...any) (commandTag pgconn.CommandTag, err error)
+	Query(ctx context.Context, sql string, args ...any) (Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) Row
+}
+`
+
+var PgconnStub = `
+package pgconn
+
+type CommandTag struct{}
+
+func (ct CommandTag) RowsAffected() int64 { return 0 }
+`
+
+var PgxpoolStub = `
+package pgxpool
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type Pool struct{}
+
+func New(ctx context.Context, connString string) (*Pool, error) { return &Pool{}, nil }
+
+func (p *Pool) Close()                                   {}
+func (p *Pool) Begin(ctx context.Context) (pgx.Tx, error) { return nil, nil }
+func (p *Pool) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+func (p *Pool) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) { return nil, nil }
+func (p *Pool) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row        { return nil }
+func (p *Pool) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults         { return nil }
+func (p *Pool) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, nil
+}
+`
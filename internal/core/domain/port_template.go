@@ -4,6 +4,7 @@ type PortFlagDomain struct {
 	FeatureName string
 	ProjectName string
 	IDType      string
+	Operations  []Operation
 }
 
 var PortsTemplate = `
@@ -32,5 +33,40 @@ type I{{ .FeatureName }}Service interface {
 	Create{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse
 	Update{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse
 	Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse
+{{ range .Operations }}	{{ .Name }}(ctx context.Context{{ .Params $.IDType $.FeatureName }}) utils.APIResponse
+{{ end }}}
+`
+
+// PurePortsTemplate renders ports that only speak domain types, so the core never imports the models adapter.
+var PurePortsTemplate = `
+package ports
+
+import (
+	"context"
+
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+)
+
+type ITransactor interface {
+	WithinTransaction(ctx context.Context, tFunc func(ctx context.Context) error) error
 }
+
+type I{{ .FeatureName }}Repository interface {
+	Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*domain.{{ .FeatureName }}Domain, error)
+	Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]domain.{{ .FeatureName }}Domain], error)
+	Create{{ .FeatureName }}(ctx context.Context, payload *domain.{{ .FeatureName }}Domain) error
+	Update{{ .FeatureName }}(ctx context.Context, payload *domain.{{ .FeatureName }}Domain) error
+	Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error
+}
+
+type I{{ .FeatureName }}Service interface {
+	Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse
+	Get{{ .FeatureName }}s(ctx context.Context) pagination.Pagination[[]domain.{{ .FeatureName }}Domain]
+	Create{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse
+	Update{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse
+	Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse
+{{ range .Operations }}	{{ .Name }}(ctx context.Context{{ .Params $.IDType $.FeatureName }}) utils.APIResponse
+{{ end }}}
 `
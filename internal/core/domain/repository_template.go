@@ -3,6 +3,11 @@ package domain
 type RepositoryFlagDomain struct {
 	FeatureName string
 	ProjectName string
+	IDType      string
+	Fields      []Field
+	PureDomain  bool // only read by the templates that serve both modes
+	UseUUID     bool // only read by the -db mongo and -orm sqlx templates
+	NoReturning bool // only read by the -orm sqlx template, see Dialect
 }
 
 var RepoTemplate = `
@@ -30,14 +35,14 @@ func New{{ .FeatureName }}Repository(db *gorm.DB) ports.I{{ .FeatureName }}Repos
 // Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
 func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *models.{{ .FeatureName }}) error {
 	tx := database.HelperExtractTx(ctx, o.db)
-	if err := tx.WithContext(ctx).Create(&payload).Error; err != nil {
+	if err := tx.WithContext(ctx).Create(payload).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 // Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
-func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id uint) error {
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
 	tx := database.HelperExtractTx(ctx, o.db)
 	if err := tx.WithContext(ctx).Where("id=?", id).Delete(&models.{{ .FeatureName }}{}).Error; err != nil {
 		return err
@@ -46,7 +51,7 @@ func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, i
 }
 
 // Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
-func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id uint) (*models.{{ .FeatureName }}, error) {
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*models.{{ .FeatureName }}, error) {
 	tx := database.HelperExtractTx(ctx, o.db)
 
 	var data models.{{ .FeatureName }}
@@ -80,9 +85,130 @@ func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*p
 // Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
 func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *models.{{ .FeatureName }}) error {
 	tx := database.HelperExtractTx(ctx, o.db)
-	if err := tx.WithContext(ctx).Save(&payload).Error; err != nil {
+	if err := tx.WithContext(ctx).Save(payload).Error; err != nil {
 		return err
 	}
 	return nil
 }
 `
+
+// PureRepoTemplate renders a repository that owns the model <-> domain mapping,
+// for use with PureDomainTemplate and PurePortsTemplate.
+var PureRepoTemplate = `
+package repositories
+
+import (
+	"context"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"gorm.io/gorm"
+)
+
+type {{ .FeatureName }}Impl struct {
+	db *gorm.DB
+}
+
+func New{{ .FeatureName }}Repository(db *gorm.DB) ports.I{{ .FeatureName }}Repository {
+	return &{{ .FeatureName }}Impl{db: db}
+}
+
+// To{{ .FeatureName }}Domain maps the persistence model to the domain entity.
+func To{{ .FeatureName }}Domain(data *models.{{ .FeatureName }}) domain.{{ .FeatureName }}Domain {
+	return domain.{{ .FeatureName }}Domain{
+		ID:        data.ID,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
+}
+
+// To{{ .FeatureName }}Model maps the domain entity to the persistence model.
+func To{{ .FeatureName }}Model(data *domain.{{ .FeatureName }}Domain) *models.{{ .FeatureName }} {
+	return &models.{{ .FeatureName }}{
+		ID:        data.ID,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
+}
+
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *domain.{{ .FeatureName }}Domain) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := To{{ .FeatureName }}Model(payload)
+	if err := tx.WithContext(ctx).Create(data).Error; err != nil {
+		return err
+	}
+	*payload = To{{ .FeatureName }}Domain(data)
+	return nil
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	if err := tx.WithContext(ctx).Where("id=?", id).Delete(&models.{{ .FeatureName }}{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*domain.{{ .FeatureName }}Domain, error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	var data models.{{ .FeatureName }}
+	if err := tx.WithContext(ctx).Where("id =?", id).First(&data).Error; err != nil {
+		return nil, err
+	}
+	res := To{{ .FeatureName }}Domain(&data)
+	return &res, nil
+}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]domain.{{ .FeatureName }}Domain], error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	p := pagination.GetFilters[filters.{{ .FeatureName }}Filter](ctx)
+	fp := p.Filters
+
+	orderBy := pagination.NewOrderBy(pagination.SortParams{
+		Sort:           p.Sort,
+		Order:          p.Order,
+		DefaultOrderBy: "updated_at DESC",
+	})
+	tx = pagination.ApplyFilter(tx, "id", fp.ID, "contains")
+	tx = tx.WithContext(ctx).Order(orderBy)
+	data, err := pagination.Paginate[filters.{{ .FeatureName }}Filter, []models.{{ .FeatureName }}](p, tx)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]domain.{{ .FeatureName }}Domain, 0, len(data.Rows))
+	for i := range data.Rows {
+		rows = append(rows, To{{ .FeatureName }}Domain(&data.Rows[i]))
+	}
+	return &pagination.Pagination[[]domain.{{ .FeatureName }}Domain]{
+		Rows:       rows,
+		Links:      data.Links,
+		Total:      data.Total,
+		Page:       data.Page,
+		PageSize:   data.PageSize,
+		TotalPages: data.TotalPages,
+	}, nil
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *domain.{{ .FeatureName }}Domain) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := To{{ .FeatureName }}Model(payload)
+	if err := tx.WithContext(ctx).Save(data).Error; err != nil {
+		return err
+	}
+	*payload = To{{ .FeatureName }}Domain(data)
+	return nil
+}
+`
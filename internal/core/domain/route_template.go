@@ -3,21 +3,23 @@ package domain
 type RouteFlagDomain struct {
 	FeatureName string
 	ProjectName string
+	Path        string      // e.g. orders
+	Operations  []Operation // routed before the CRUD routes, so that /orders/search is not taken for an ID
 }
 
 var RouteTemplate = `
 package routers
 
 import (
-	handlers "{{ .ProjectName }}/internal/adapters/handlers/{{ .FeatureName | ToLower }}"
-	"{{ .ProjectName }}/pkg/middlewares"
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
 )
 
 func (r RouterImpl) Create{{ .FeatureName }}Routes(h handlers.I{{ .FeatureName }}Handler) {
-	r.route.Get("/{{ .FeatureName | Pluralize | ToLower }}", h.HandleGet{{ .FeatureName }}s)
-	r.route.Get("/{{ .FeatureName | Pluralize | ToLower }}/:id", h.HandleGet{{ .FeatureName }})
-	r.route.Post("/{{ .FeatureName | Pluralize | ToLower }}", h.HandleCreate{{ .FeatureName }})
-	r.route.Put("/{{ .FeatureName | Pluralize | ToLower }}/:id", h.HandleUpdate{{ .FeatureName }})
-	r.route.Delete("/{{ .FeatureName | Pluralize | ToLower }}/:id", h.HandleDelete{{ .FeatureName }})
+{{ range .Operations }}	r.route.{{ .Func }}("/{{ $.Path }}{{ .ColonPath }}", h.Handle{{ .Name }})
+{{ end }}	r.route.Get("/{{ .Path }}", h.HandleGet{{ .FeatureName }}s)
+	r.route.Get("/{{ .Path }}/:id", h.HandleGet{{ .FeatureName }})
+	r.route.Post("/{{ .Path }}", h.HandleCreate{{ .FeatureName }})
+	r.route.Put("/{{ .Path }}/:id", h.HandleUpdate{{ .FeatureName }})
+	r.route.Delete("/{{ .Path }}/:id", h.HandleDelete{{ .FeatureName }})
 }
 `
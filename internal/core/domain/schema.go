@@ -0,0 +1,93 @@
+package domain
+
+// SchemaTable is a table of an existing database, as read by an introspector or parsed from DDL.
+type SchemaTable struct {
+	Name    string
+	Columns []SchemaColumn
+}
+
+// SchemaColumn is a column of a SchemaTable.
+type SchemaColumn struct {
+	Name       string
+	Type       string // SQL type as declared, e.g. varchar(255) or int unsigned
+	Nullable   bool
+	Default    string // SQL expression, if any
+	PrimaryKey bool
+	Index      string // one of FieldIndexes, if the column alone has an index
+	References string // table the column references, if it has a foreign key to its id
+}
+
+// FeatureSpec is a feature imported from a table or an OpenAPI resource, in the terms of the
+// generator flags.
+type FeatureSpec struct {
+	Feature    string      `json:"feature"`
+	Table      string      `json:"table"`
+	UUID       bool        `json:"uuid,omitempty"`
+	Fields     string      `json:"fields"`         // in the syntax of -fields
+	Path       string      `json:"path,omitempty"` // of the routes, when it is not the lower-case plural of Feature
+	Operations []Operation `json:"operations,omitempty"`
+}
+
+// ImportFlag holds the flags of gohexa import.
+type ImportFlag struct {
+	DSN         *string `json:"dsn"`
+	Tables      *string `json:"tables"`
+	Generate    *bool   `json:"generate"`
+	Output      *string `json:"output"`
+	ProjectName *string `json:"project"`
+	PureDomain  *bool   `json:"pure"`
+	HTTP        *string `json:"http"`
+	ORM         *string `json:"orm"`
+	DB          *string `json:"db"`
+	Migration   *string `json:"migration"`
+}
+
+// ImportTypes maps the base SQL types of imported columns, without length or precision, to the Go
+// types of fields. Types not listed are matched by the affinity rules of SQLite.
+var ImportTypes = map[string]string{
+	"text":                        "string",
+	"varchar":                     "string",
+	"char":                        "string",
+	"character":                   "string",
+	"character varying":           "string",
+	"citext":                      "string",
+	"uuid":                        "string",
+	"json":                        "string",
+	"jsonb":                       "string",
+	"enum":                        "string",
+	"tinytext":                    "string",
+	"mediumtext":                  "string",
+	"longtext":                    "string",
+	"smallint":                    "int32",
+	"int2":                        "int32",
+	"mediumint":                   "int32",
+	"integer":                     "int32",
+	"int":                         "int32",
+	"int4":                        "int32",
+	"serial":                      "int32",
+	"bigint":                      "int64",
+	"int8":                        "int64",
+	"bigserial":                   "int64",
+	"real":                        "float32",
+	"float4":                      "float32",
+	"float":                       "float32",
+	"double":                      "float64",
+	"double precision":            "float64",
+	"float8":                      "float64",
+	"numeric":                     "float64",
+	"decimal":                     "float64",
+	"boolean":                     "bool",
+	"bool":                        "bool",
+	"timestamp":                   "time.Time",
+	"timestamptz":                 "time.Time",
+	"timestamp with time zone":    "time.Time",
+	"timestamp without time zone": "time.Time",
+	"datetime":                    "time.Time",
+	"date":                        "time.Time",
+}
+
+// UnsignedTypes maps the Go types of ImportTypes to their unsigned type, for MySQL unsigned columns.
+var UnsignedTypes = map[string]string{
+	"int32": "uint32",
+	"int64": "uint64",
+}
@@ -3,6 +3,8 @@ package domain
 type ServiceFlagDomain struct {
 	FeatureName string
 	ProjectName string
+	IDType      string
+	Operations  []Operation
 }
 
 var ServiceTemplate = `
@@ -32,7 +34,7 @@ func New{{ .FeatureName }}Service(
 }
 
 // Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
-func (s *{{ .FeatureName }}ServiceImpl) Create{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}) utils.APIResponse {
+func (s *{{ .FeatureName }}ServiceImpl) Create{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse {
 	data := domain.To{{ .FeatureName }}Model(payload)
 	if err := s.repo.Create{{ .FeatureName }}(ctx, data); err != nil {
 		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
@@ -41,7 +43,7 @@ func (s *{{ .FeatureName }}ServiceImpl) Create{{ .FeatureName }}(ctx context.Con
 }
 
 // Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
-func (s *{{ .FeatureName }}ServiceImpl) Delete{{ .FeatureName }}(ctx context.Context, id uint) utils.APIResponse {
+func (s *{{ .FeatureName }}ServiceImpl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse {
 	if err := s.repo.Delete{{ .FeatureName }}(ctx, id); err != nil {
 		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
 	}
@@ -49,7 +51,7 @@ func (s *{{ .FeatureName }}ServiceImpl) Delete{{ .FeatureName }}(ctx context.Con
 }
 
 // Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
-func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}(ctx context.Context, id uint) utils.APIResponse {
+func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse {
 	data, err := s.repo.Get{{ .FeatureName }}(ctx, id)
 	if err != nil {
 		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
@@ -62,14 +64,17 @@ func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}(ctx context.Contex
 }
 
 // Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Service.
-func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}s(ctx context.Context) pagination.Pagination[[]domain.{{ .FeatureName }}] {
+func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}s(ctx context.Context) pagination.Pagination[[]domain.{{ .FeatureName }}Domain] {
 	data, err := s.repo.Get{{ .FeatureName }}s(ctx)
 	if err != nil {
-		return pagination.Pagination[[]domain.{{ .FeatureName }}]{}
+		return pagination.Pagination[[]domain.{{ .FeatureName }}Domain]{}
 	}
 	// Convert repository data to domain models
-	newData := utils.ConvertSlice(data.Rows, domain.To{{ .FeatureName }}Domain)
-	return pagination.Pagination[[]domain.{{ .FeatureName }}]{
+	newData := make([]domain.{{ .FeatureName }}Domain, 0, len(data.Rows))
+	for i := range data.Rows {
+		newData = append(newData, domain.To{{ .FeatureName }}Domain(&data.Rows[i]))
+	}
+	return pagination.Pagination[[]domain.{{ .FeatureName }}Domain]{
 		Rows:       newData,
 		Links:      data.Links,
 		Total:      data.Total,
@@ -80,7 +85,7 @@ func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}s(ctx context.Conte
 }
 
 // Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
-func (s *{{ .FeatureName }}ServiceImpl) Update{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}) utils.APIResponse {
+func (s *{{ .FeatureName }}ServiceImpl) Update{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse {
 	data := domain.To{{ .FeatureName }}Model(payload)
 	if err := s.repo.Update{{ .FeatureName }}(ctx, data); err != nil {
 		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
@@ -88,4 +93,86 @@ func (s *{{ .FeatureName }}ServiceImpl) Update{{ .FeatureName }}(ctx context.Con
 	res := domain.To{{ .FeatureName }}Domain(data)
 	return utils.APIResponse{StatusCode: configs.API_SUCCESS_CODE, StatusMessage: "Success", Data: res}
 }
-`
+` + serviceOperations
+
+// PureServiceTemplate renders a service that depends on ports only, for use with PurePortsTemplate.
+var PureServiceTemplate = `
+package services
+
+import (
+	"context"
+
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+)
+
+type {{ .FeatureName }}ServiceImpl struct {
+	repo       ports.I{{ .FeatureName }}Repository
+	transactor ports.ITransactor
+}
+
+func New{{ .FeatureName }}Service(
+	repo ports.I{{ .FeatureName }}Repository,
+	transactor ports.ITransactor,
+) ports.I{{ .FeatureName }}Service {
+	return &{{ .FeatureName }}ServiceImpl{repo: repo, transactor: transactor}
+}
+
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
+func (s *{{ .FeatureName }}ServiceImpl) Create{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse {
+	if err := s.repo.Create{{ .FeatureName }}(ctx, &payload); err != nil {
+		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
+	}
+	return utils.APIResponse{StatusCode: configs.API_SUCCESS_CODE, StatusMessage: "Success", Data: payload}
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
+func (s *{{ .FeatureName }}ServiceImpl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse {
+	if err := s.repo.Delete{{ .FeatureName }}(ctx, id); err != nil {
+		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
+	}
+	return utils.APIResponse{StatusCode: configs.API_SUCCESS_CODE, StatusMessage: "Success", Data: nil}
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
+func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) utils.APIResponse {
+	data, err := s.repo.Get{{ .FeatureName }}(ctx, id)
+	if err != nil {
+		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
+	}
+	if data == nil {
+		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Not Found", Data: nil}
+	}
+	return utils.APIResponse{StatusCode: configs.API_SUCCESS_CODE, StatusMessage: "Success", Data: data}
+}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Service.
+func (s *{{ .FeatureName }}ServiceImpl) Get{{ .FeatureName }}s(ctx context.Context) pagination.Pagination[[]domain.{{ .FeatureName }}Domain] {
+	data, err := s.repo.Get{{ .FeatureName }}s(ctx)
+	if err != nil {
+		return pagination.Pagination[[]domain.{{ .FeatureName }}Domain]{}
+	}
+	return *data
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Service.
+func (s *{{ .FeatureName }}ServiceImpl) Update{{ .FeatureName }}(ctx context.Context, payload domain.{{ .FeatureName }}Domain) utils.APIResponse {
+	if err := s.repo.Update{{ .FeatureName }}(ctx, &payload); err != nil {
+		return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Error", Data: err}
+	}
+	return utils.APIResponse{StatusCode: configs.API_SUCCESS_CODE, StatusMessage: "Success", Data: payload}
+}
+` + serviceOperations
+
+// serviceOperations renders the methods of the operations of a feature besides its CRUD ones, which
+// are left to implement.
+var serviceOperations = `{{ range .Operations }}
+// {{ .Name }} implements ports.I{{ $.FeatureName }}Service.{{ with .Summary }} {{ . }}{{ end }}
+func (s *{{ $.FeatureName }}ServiceImpl) {{ .Name }}(ctx context.Context{{ .Params $.IDType $.FeatureName }}) utils.APIResponse {
+	// TODO: implement {{ .Method }} {{ .Path }}.
+	return utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Not Implemented", Data: nil}
+}
+{{ end }}`
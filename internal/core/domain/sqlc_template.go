@@ -0,0 +1,320 @@
+package domain
+
+type SqlcFlagDomain struct {
+	FeatureName string
+	ProjectName string
+	IDType      string // Go type of the ID in the ports
+	UseUUID     bool
+	PureDomain  bool
+	Table       string // e.g. orders
+	RowType     string // Go type sqlc generates for a row of Table, e.g. Orders
+	IDGoType    string // Go type sqlc generates for the id column, e.g. int64
+	Fields      []SqlcField
+	Nullable    bool // a field is nullable, so the conversions need the "nullable" helpers
+	Dialect     Dialect
+}
+
+// SqlcField is a column of the table of a feature, with the Go expressions converting it from the
+// model data to the sqlc parameters and to the model from the sqlc row.
+type SqlcField struct {
+	Name     string // Go field name in the model, e.g. CustomerID
+	Column   string // e.g. customer_id
+	SQLType  string // PostgreSQL column type, e.g. BIGINT
+	Nullable bool
+	GoName   string // Go field name sqlc generates, e.g. CustomerID
+	GoType   string // Go type sqlc generates, e.g. int64, or sql.NullInt64 when Nullable
+	ToSqlc   string // e.g. int64(data.CustomerID)
+	FromSqlc string // e.g. uint(row.CustomerID)
+}
+
+// SqlcConfigTemplate is the sqlc.yaml written to the project root when it is missing. Exact table
+// names keep the row types predictable for the repositories, e.g. Orders for the orders table.
+var SqlcConfigTemplate = `version: "2"
+sql:
+  - engine: "postgresql"
+    schema: "internal/adapters/database/schema"
+    queries: "internal/adapters/database/queries"
+    gen:
+      go:
+        package: "sqlc"
+        out: "internal/adapters/database/sqlc"
+        emit_exact_table_names: true
+`
+
+var SqlcSchemaTemplate = `-- Schema of the {{ .Table }} table, read by sqlc. Apply it to the database with your migration tool.
+{{ if and .UseUUID .Dialect.UUIDSetup }}{{ .Dialect.UUIDSetup }}
+
+{{ end }}CREATE TABLE IF NOT EXISTS {{ .Table }} (
+    id {{ if .UseUUID }}UUID PRIMARY KEY DEFAULT {{ .Dialect.UUIDDefault }}{{ else }}BIGSERIAL PRIMARY KEY{{ end }},
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(){{ range .Fields }},
+    {{ .Column }} {{ .SQLType }}{{ if not .Nullable }} NOT NULL{{ end }}{{ end }}
+);
+`
+
+var SqlcQueriesTemplate = `-- name: Get{{ .FeatureName }} :one
+SELECT * FROM {{ .Table }}
+WHERE id = @id
+LIMIT 1;
+
+-- name: List{{ .FeatureName }}s :many
+SELECT * FROM {{ .Table }}
+WHERE sqlc.narg('id')::text IS NULL OR id::text = sqlc.narg('id')
+ORDER BY updated_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: Count{{ .FeatureName }}s :one
+SELECT COUNT(*) FROM {{ .Table }}
+WHERE sqlc.narg('id')::text IS NULL OR id::text = sqlc.narg('id');
+
+-- name: Create{{ .FeatureName }} :one
+INSERT INTO {{ .Table }} (created_at, updated_at{{ range .Fields }}, {{ .Column }}{{ end }})
+VALUES (@created_at, @updated_at{{ range .Fields }}, @{{ .Column }}{{ end }})
+RETURNING *;
+
+-- name: Update{{ .FeatureName }} :one
+UPDATE {{ .Table }}
+SET updated_at = @updated_at{{ range .Fields }}, {{ .Column }} = @{{ .Column }}{{ end }}
+WHERE id = @id
+RETURNING *;
+
+-- name: Delete{{ .FeatureName }} :exec
+DELETE FROM {{ .Table }}
+WHERE id = @id;
+`
+
+// SqlcRepoTemplate renders the repository for -orm sqlc. It adapts the Queries sqlc generates from
+// SqlcQueriesTemplate to the port and runs them in the *sqlx.Tx of the context with WithTx. It
+// serves both the default and the -pure ports.
+var SqlcRepoTemplate = `
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+	"github.com/{{ .ProjectName }}/internal/adapters/database/sqlc"
+{{ if .PureDomain }}	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+{{ end }}	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+{{ if .UseUUID }}	"github.com/google/uuid"
+{{ end }}	"github.com/jmoiron/sqlx"
+)
+
+type {{ .FeatureName }}Impl struct {
+	q *sqlc.Queries
+}
+
+func New{{ .FeatureName }}Repository(db *sqlx.DB) ports.I{{ .FeatureName }}Repository {
+	return &{{ .FeatureName }}Impl{q: sqlc.New(db)}
+}
+
+// queries returns the sqlc queries, bound to the transaction of the context if there is one.
+func (o *{{ .FeatureName }}Impl) queries(ctx context.Context) *sqlc.Queries {
+	if tx := database.ExtractTx(ctx); tx != nil {
+		return o.q.WithTx(tx.Tx)
+	}
+	return o.q
+}
+
+// {{ .FeatureName | ToLowerCamel }}ID converts an ID of the port to the type of the sqlc queries.
+func {{ .FeatureName | ToLowerCamel }}ID(id {{ .IDType }}) ({{ .IDGoType }}, error) {
+{{ if .UseUUID }}	return uuid.Parse(id)
+{{ else }}	return {{ .IDGoType }}(id), nil
+{{ end }}}
+
+// to{{ .FeatureName }}Model maps a row of the sqlc queries to the model.
+func to{{ .FeatureName }}Model(row sqlc.{{ .RowType }}) *models.{{ .FeatureName }} {
+	return &models.{{ .FeatureName }}{
+		ID:        {{ if .UseUUID }}row.ID.String(){{ else }}{{ .IDType }}(row.ID){{ end }},
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: {{ .FromSqlc }},
+{{ end }}	}
+}
+{{ template "mappers" . }}
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	now := time.Now()
+	row, err := o.queries(ctx).Create{{ .FeatureName }}(ctx, sqlc.Create{{ .FeatureName }}Params{
+		CreatedAt: now,
+		UpdatedAt: now,
+{{ range .Fields }}		{{ .GoName }}: {{ .ToSqlc }},
+{{ end }}	})
+	if err != nil {
+		return err
+	}
+	*payload = {{ template "fromRow" . }}
+	return nil
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
+	key, err := {{ .FeatureName | ToLowerCamel }}ID(id)
+	if err != nil {
+		return err
+	}
+	return o.queries(ctx).Delete{{ .FeatureName }}(ctx, key)
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*{{ template "entity" . }}, error) {
+	key, err := {{ .FeatureName | ToLowerCamel }}ID(id)
+	if err != nil {
+		return nil, err
+	}
+	row, err := o.queries(ctx).Get{{ .FeatureName }}(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	res := {{ template "fromRow" . }}
+	return &res, nil
+}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Repository.
+// sqlc queries are static, so rows are ordered by updated_at DESC and the sort parameters are not applied.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]{{ template "entity" . }}], error) {
+	q := o.queries(ctx)
+
+	p := pagination.GetFilters[filters.{{ .FeatureName }}Filter](ctx)
+	fp := p.Filters
+
+	page, pageSize := p.Page, p.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	id := sql.NullString{String: fp.ID, Valid: fp.ID != ""}
+
+	total, err := q.Count{{ .FeatureName }}s(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.List{{ .FeatureName }}s(ctx, sqlc.List{{ .FeatureName }}sParams{
+		ID:     id,
+		Limit:  int32(pageSize),
+		Offset: int32((page - 1) * pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]{{ template "entity" . }}, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, {{ template "fromRow" . }})
+	}
+	return &pagination.Pagination[[]{{ template "entity" . }}]{
+		Rows:       res,
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	key, err := {{ .FeatureName | ToLowerCamel }}ID(data.ID)
+	if err != nil {
+		return err
+	}
+	row, err := o.queries(ctx).Update{{ .FeatureName }}(ctx, sqlc.Update{{ .FeatureName }}Params{
+		ID:        key,
+		UpdatedAt: time.Now(),
+{{ range .Fields }}		{{ .GoName }}: {{ .ToSqlc }},
+{{ end }}	})
+	if err != nil {
+		return err
+	}
+	*payload = {{ template "fromRow" . }}
+	return nil
+}
+{{ if .Nullable }}{{ template "nullable" . }}{{ end }}
+{{- define "fromRow" }}{{ if .PureDomain }}To{{ .FeatureName }}Domain(to{{ .FeatureName }}Model(row)){{ else }}*to{{ .FeatureName }}Model(row){{ end }}{{ end }}` + repoModeTemplate + nullableTemplate
+
+// SqlcStub declares what sqlc generates from SqlcSchemaTemplate and SqlcQueriesTemplate. It is only
+// used to verify the repository.
+var SqlcStub = `
+package sqlc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+{{ if .UseUUID }}
+	"github.com/google/uuid"
+{{ end }})
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+func New(db DBTX) *Queries { return &Queries{db: db} }
+
+type Queries struct {
+	db DBTX
+}
+
+func (q *Queries) WithTx(tx *sql.Tx) *Queries { return &Queries{db: tx} }
+
+type {{ .RowType }} struct {
+	ID        {{ .IDGoType }}
+	CreatedAt time.Time
+	UpdatedAt time.Time
+{{ range .Fields }}	{{ .GoName }} {{ .GoType }}
+{{ end }}}
+
+type Create{{ .FeatureName }}Params struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+{{ range .Fields }}	{{ .GoName }} {{ .GoType }}
+{{ end }}}
+
+type Update{{ .FeatureName }}Params struct {
+	UpdatedAt time.Time
+{{ range .Fields }}	{{ .GoName }} {{ .GoType }}
+{{ end }}	ID {{ .IDGoType }}
+}
+
+type List{{ .FeatureName }}sParams struct {
+	ID     sql.NullString
+	Limit  int32
+	Offset int32
+}
+
+func (q *Queries) Count{{ .FeatureName }}s(ctx context.Context, id sql.NullString) (int64, error) { return 0, nil }
+func (q *Queries) Create{{ .FeatureName }}(ctx context.Context, arg Create{{ .FeatureName }}Params) ({{ .RowType }}, error) {
+	return {{ .RowType }}{}, nil
+}
+func (q *Queries) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDGoType }}) error { return nil }
+func (q *Queries) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDGoType }}) ({{ .RowType }}, error) {
+	return {{ .RowType }}{}, nil
+}
+func (q *Queries) List{{ .FeatureName }}s(ctx context.Context, arg List{{ .FeatureName }}sParams) ([]{{ .RowType }}, error) {
+	return nil, nil
+}
+func (q *Queries) Update{{ .FeatureName }}(ctx context.Context, arg Update{{ .FeatureName }}Params) ({{ .RowType }}, error) {
+	return {{ .RowType }}{}, nil
+}
+`
+
+var UUIDStub = `
+package uuid
+
+type UUID [16]byte
+
+func New() UUID                      { return UUID{} }
+func NewString() string              { return "" }
+func Parse(s string) (UUID, error)   { return UUID{}, nil }
+func (uuid UUID) String() string     { return "" }
+`
@@ -0,0 +1,453 @@
+package domain
+
+// SqlxModelsTemplate renders the model of a feature for -orm sqlx, pgx and sqlc. Columns are mapped with
+// db tags and rows are deleted for good, there is no soft delete.
+var SqlxModelsTemplate = `
+package models
+
+import "time"
+
+type {{ .FeatureName }} struct {
+	{{ if .UseUUID }}ID        string    ` + "`db:\"id\" json:\"id\"`" + `{{ else }}ID        uint      ` + "`db:\"id\" json:\"id\"`" + `{{ end }}
+	CreatedAt time.Time ` + "`db:\"created_at\" json:\"created_at\"`" + `
+	UpdatedAt time.Time ` + "`db:\"updated_at\" json:\"updated_at\"`" + `
+{{ range .Fields }}	{{ .Name }} {{ .GoType }} ` + "`db:\"{{ .Column }}\" json:\"{{ .Column }}\"`" + `
+{{ end }}}
+
+var TN{{ .FeatureName }} = "{{ .Table }}"
+
+func (st *{{ .FeatureName }}) TableName() string {
+	return TN{{ .FeatureName }}
+}
+`
+
+// SqlxTransactorTemplate renders the transactor for -orm sqlx. It keeps the *sqlx.Tx in the context
+// and has the same WithinTransaction contract as TransactorTemplate.
+var SqlxTransactorTemplate = `
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Idea https://www.kaznacheev.me/posts/en/clean-transactions-in-hexagon/
+type txKey struct{}
+
+// DBTX is implemented by both *sqlx.DB and *sqlx.Tx, so repositories run the same queries
+// inside and outside of a transaction.
+type DBTX interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+}
+
+// injectTx injects the transaction into the context
+func InjectTx(ctx context.Context, tx *sqlx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// extractTx extracts the transaction from the context
+func ExtractTx(ctx context.Context) *sqlx.Tx {
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return nil
+}
+
+func HelperExtractTx(ctx context.Context, db *sqlx.DB) DBTX {
+	if tx := ExtractTx(ctx); tx != nil {
+		return tx
+	}
+	return db
+}
+
+type TransactorImpl struct {
+	db *sqlx.DB
+}
+
+// BeginTransaction implements IDatabaseTransactor.
+func (d *TransactorImpl) BeginTransaction() (*sqlx.Tx, error) {
+	tx, err := d.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	return tx, nil
+}
+
+// RollbackTransaction rolls back the transaction if it was started and returns any error encountered.
+// A transaction that was already committed or rolled back is not an error.
+func (d *TransactorImpl) RollbackTransaction(tx *sqlx.Tx) error {
+	if tx == nil {
+		return nil // No transaction to rollback
+	}
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return fmt.Errorf("failed to rollback transaction: %w", err)
+	}
+	return nil
+}
+
+// WithinTransaction implements IDatabaseTransactor.
+// WithinTransaction runs the provided function within a transaction context.
+// The transaction is automatically committed if the function completes successfully, or rolled back if an error occurs.
+func (d *TransactorImpl) WithinTransaction(ctx context.Context, tFunc func(ctx context.Context) error) (err error) {
+	// begin transaction
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	// Ensure that the transaction is finalized properly
+	defer func() {
+		if r := recover(); r != nil {
+			_ = d.RollbackTransaction(tx)
+			panic(r) // Re-panic after rollback
+		} else if err != nil {
+			_ = d.RollbackTransaction(tx)
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			log.Printf("failed to commit transaction: %v", commitErr)
+			err = commitErr
+		}
+	}()
+
+	// Run the callback function with the transaction context
+	return tFunc(InjectTx(ctx, tx))
+}
+
+// WithTransactionContextTimeout executes a function within a transaction with a specified context timeout.
+// The transaction is committed if successful, or rolled back if an error occurs or the context times out.
+func (d *TransactorImpl) WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) (err error) {
+	// Create a new context with timeout
+	transactionCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Start a new transaction
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	// Ensure that the transaction is finalized properly
+	defer func() {
+		if err == nil {
+			err = transactionCtx.Err() // Rollback if the context is done (timeout or cancel)
+		}
+		if err != nil {
+			if rollbackErr := d.RollbackTransaction(tx); rollbackErr != nil {
+				log.Printf("failed to rollback transaction: %v", rollbackErr)
+			}
+		} else if commitErr := tx.Commit(); commitErr != nil {
+			log.Printf("failed to commit transaction: %v", commitErr)
+			err = commitErr
+		}
+	}()
+
+	// Run the callback function with the transaction context
+	return tFunc(InjectTx(transactionCtx, tx))
+}
+
+type IDatabaseTransactor interface {
+	WithinTransaction(context.Context, func(ctx context.Context) error) error
+	WithTransactionContextTimeout(ctx context.Context, timeout time.Duration, tFunc func(ctx context.Context) error) error
+	BeginTransaction() (*sqlx.Tx, error)
+	RollbackTransaction(tx *sqlx.Tx) error
+}
+
+func NewTransactorRepo(db *sqlx.DB) IDatabaseTransactor {
+	return &TransactorImpl{db: db}
+}
+`
+
+// SqlxRepoTemplate renders the repository for -orm sqlx, with the SQL written out from the fields of
+// the feature. It serves both the default and the -pure ports: with PureDomain it maps the model to
+// the domain entity like PureRepoTemplate. With NoReturning the insert reads the auto-increment id
+// from the result instead, or sets the -uuid id itself.
+var SqlxRepoTemplate = `
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	"github.com/{{ .ProjectName }}/internal/adapters/database/models"
+{{ if .PureDomain }}	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+{{ end }}	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+{{ if and .UseUUID .NoReturning }}	"github.com/google/uuid"
+{{ end }}	"github.com/jmoiron/sqlx"
+)
+
+// Queries of the models.TN{{ .FeatureName }} table. Named parameters are bound from the db tags of
+// the model; the others are written as ? and rebound for the driver.
+var (
+	select{{ .FeatureName }}Query = "SELECT id, created_at, updated_at{{ range .Fields }}, {{ .Column }}{{ end }} FROM " + models.TN{{ .FeatureName }}
+	count{{ .FeatureName }}Query  = "SELECT COUNT(*) FROM " + models.TN{{ .FeatureName }}
+	insert{{ .FeatureName }}Query = "INSERT INTO " + models.TN{{ .FeatureName }} + " ({{ if and .UseUUID .NoReturning }}id, {{ end }}created_at, updated_at{{ range .Fields }}, {{ .Column }}{{ end }}) VALUES ({{ if and .UseUUID .NoReturning }}:id, {{ end }}:created_at, :updated_at{{ range .Fields }}, :{{ .Column }}{{ end }}){{ if not .NoReturning }} RETURNING id{{ end }}"
+	update{{ .FeatureName }}Query = "UPDATE " + models.TN{{ .FeatureName }} + " SET updated_at = :updated_at{{ range .Fields }}, {{ .Column }} = :{{ .Column }}{{ end }} WHERE id = :id"
+	delete{{ .FeatureName }}Query = "DELETE FROM " + models.TN{{ .FeatureName }} + " WHERE id = ?"
+)
+
+type {{ .FeatureName }}Impl struct {
+	db *sqlx.DB
+}
+
+func New{{ .FeatureName }}Repository(db *sqlx.DB) ports.I{{ .FeatureName }}Repository {
+	return &{{ .FeatureName }}Impl{db: db}
+}
+{{ template "mappers" . }}
+// Create{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Create{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.CreatedAt = time.Now()
+	data.UpdatedAt = data.CreatedAt
+
+{{ if not .NoReturning }}	// The insert returns the generated id, so it runs as a query rather than NamedExec.
+	rows, err := sqlx.NamedQueryContext(ctx, tx, insert{{ .FeatureName }}Query, data)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	if rows.Next() {
+		if err := rows.Scan(&data.ID); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+{{ else if .UseUUID }}	// The database cannot return the id it would generate, so it is generated here.
+	data.ID = uuid.NewString()
+	if _, err := sqlx.NamedExecContext(ctx, tx, insert{{ .FeatureName }}Query, data); err != nil {
+		return err
+	}
+{{ else }}	// The database has no RETURNING, so the generated id is read from the result.
+	res, err := sqlx.NamedExecContext(ctx, tx, insert{{ .FeatureName }}Query, data)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	data.ID = uint(id)
+{{ end }}{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+
+// Delete{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Delete{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	if _, err := tx.ExecContext(ctx, tx.Rebind(delete{{ .FeatureName }}Query), id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Get{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}(ctx context.Context, id {{ .IDType }}) (*{{ template "entity" . }}, error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	var data models.{{ .FeatureName }}
+	if err := tx.GetContext(ctx, &data, tx.Rebind(select{{ .FeatureName }}Query+" WHERE id = ?"), id); err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	res := To{{ .FeatureName }}Domain(&data)
+	return &res, nil
+{{ else }}	return &data, nil
+{{ end }}}
+
+// Get{{ .FeatureName }}s implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Get{{ .FeatureName }}s(ctx context.Context) (*pagination.Pagination[[]{{ template "entity" . }}], error) {
+	tx := database.HelperExtractTx(ctx, o.db)
+
+	p := pagination.GetFilters[filters.{{ .FeatureName }}Filter](ctx)
+	fp := p.Filters
+
+	orderBy := pagination.NewOrderBy(pagination.SortParams{
+		Sort:           p.Sort,
+		Order:          p.Order,
+		DefaultOrderBy: "updated_at DESC",
+	})
+	where, args := "", []interface{}{}
+	if fp.ID != "" {
+		where = " WHERE id = ?"
+		args = append(args, fp.ID)
+	}
+
+	var total int64
+	if err := tx.GetContext(ctx, &total, tx.Rebind(count{{ .FeatureName }}Query+where), args...); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := p.Page, p.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	data := make([]models.{{ .FeatureName }}, 0, pageSize)
+	query := select{{ .FeatureName }}Query + where + " ORDER BY " + orderBy + " LIMIT ? OFFSET ?"
+	if err := tx.SelectContext(ctx, &data, tx.Rebind(query), append(args, pageSize, (page-1)*pageSize)...); err != nil {
+		return nil, err
+	}
+{{ if .PureDomain }}	rows := make([]domain.{{ .FeatureName }}Domain, 0, len(data))
+	for i := range data {
+		rows = append(rows, To{{ .FeatureName }}Domain(&data[i]))
+	}
+{{ end }}	return &pagination.Pagination[[]{{ template "entity" . }}]{
+		Rows:       {{ if .PureDomain }}rows{{ else }}data{{ end }},
+		Total:      total,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalPages: int((total + int64(pageSize) - 1) / int64(pageSize)),
+	}, nil
+}
+
+// Update{{ .FeatureName }} implements ports.I{{ .FeatureName }}Repository.
+func (o *{{ .FeatureName }}Impl) Update{{ .FeatureName }}(ctx context.Context, payload *{{ template "entity" . }}) error {
+	tx := database.HelperExtractTx(ctx, o.db)
+	data := {{ if .PureDomain }}To{{ .FeatureName }}Model(payload){{ else }}payload{{ end }}
+	data.UpdatedAt = time.Now()
+	if _, err := tx.NamedExecContext(ctx, update{{ .FeatureName }}Query, data); err != nil {
+		return err
+	}
+{{ if .PureDomain }}	*payload = To{{ .FeatureName }}Domain(data)
+{{ end }}	return nil
+}
+` + repoModeTemplate
+
+// repoModeTemplate is shared by the repository templates that serve both the default and the -pure
+// ports. It defines the "entity" the ports take and, with PureDomain, the model <-> domain mappers.
+var repoModeTemplate = `{{ define "entity" }}{{ if .PureDomain }}domain.{{ .FeatureName }}Domain{{ else }}models.{{ .FeatureName }}{{ end }}{{ end }}
+{{- define "mappers" }}{{ if .PureDomain }}
+// To{{ .FeatureName }}Domain maps the persistence model to the domain entity.
+func To{{ .FeatureName }}Domain(data *models.{{ .FeatureName }}) domain.{{ .FeatureName }}Domain {
+	return domain.{{ .FeatureName }}Domain{
+		ID:        data.ID,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
+}
+
+// To{{ .FeatureName }}Model maps the domain entity to the persistence model.
+func To{{ .FeatureName }}Model(data *domain.{{ .FeatureName }}Domain) *models.{{ .FeatureName }} {
+	return &models.{{ .FeatureName }}{
+		ID:        data.ID,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+{{ range .Fields }}		{{ .Name }}: data.{{ .Name }},
+{{ end }}	}
+}
+{{ end }}{{ end }}`
+
+var SqlxStub = `
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+)
+
+type QueryerContext interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryxContext(ctx context.Context, query string, args ...interface{}) (*Rows, error)
+	QueryRowxContext(ctx context.Context, query string, args ...interface{}) *Row
+}
+
+type ExecerContext interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+type ExtContext interface {
+	DriverName() string
+	Rebind(string) string
+	BindNamed(string, interface{}) (string, []interface{}, error)
+	QueryerContext
+	ExecerContext
+}
+
+type Rows struct{ *sql.Rows }
+
+type Row struct{}
+
+func (r *Row) Scan(dest ...interface{}) error { return nil }
+
+func NamedQueryContext(ctx context.Context, e ExtContext, query string, arg interface{}) (*Rows, error) {
+	return &Rows{}, nil
+}
+
+func NamedExecContext(ctx context.Context, e ExtContext, query string, arg interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+type DB struct{}
+
+func Open(driverName, dataSourceName string) (*DB, error) { return &DB{}, nil }
+
+func (db *DB) Beginx() (*Tx, error)                                               { return &Tx{}, nil }
+func (db *DB) DriverName() string                                                 { return "" }
+func (db *DB) Rebind(query string) string                                         { return query }
+func (db *DB) BindNamed(query string, arg interface{}) (string, []interface{}, error) { return query, nil, nil }
+func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+func (db *DB) QueryxContext(ctx context.Context, query string, args ...interface{}) (*Rows, error) {
+	return &Rows{}, nil
+}
+func (db *DB) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *Row { return &Row{} }
+func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+func (db *DB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) { return nil, nil }
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+func (db *DB) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return nil
+}
+func (db *DB) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return nil
+}
+func (db *DB) NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	return nil, nil
+}
+
+type Tx struct{ *sql.Tx }
+
+func (tx *Tx) Commit() error                                                      { return nil }
+func (tx *Tx) Rollback() error                                                    { return nil }
+func (tx *Tx) DriverName() string                                                 { return "" }
+func (tx *Tx) Rebind(query string) string                                         { return query }
+func (tx *Tx) BindNamed(query string, arg interface{}) (string, []interface{}, error) { return query, nil, nil }
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+func (tx *Tx) QueryxContext(ctx context.Context, query string, args ...interface{}) (*Rows, error) {
+	return &Rows{}, nil
+}
+func (tx *Tx) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *Row { return &Row{} }
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return nil, nil
+}
+func (tx *Tx) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return nil
+}
+func (tx *Tx) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return nil
+}
+func (tx *Tx) NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	return nil, nil
+}
+`
@@ -0,0 +1,223 @@
+package domain
+
+// StdlibHandlerIDTemplate defines "parseID" for net/http handlers, see HandlerIDTemplate.
+var StdlibHandlerIDTemplate = `{{ define "parseID" }}{{ if eq .IDType "string" }}	id := r.PathValue("id")
+{{ else }}	parsedID, err := strconv.ParseUint(r.PathValue("id"), 10, 0)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid ID", Data: err.Error()})
+		return
+	}
+	id := {{ .IDType }}(parsedID)
+{{ end }}{{ end }}`
+
+// StdlibHandlerValidateTemplate defines "validate", which rejects a payload breaking the constraints of
+// the fields of the feature.
+var StdlibHandlerValidateTemplate = `{{ define "validate" }}	if err := payload.Validate(); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ end }}`
+
+var StdlibHandlerTemplate = StdlibHandlerIDTemplate + StdlibHandlerValidateTemplate + `
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	domain "github.com/{{ .ProjectName }}/internal/core/domain/{{ .FeatureName | ToLower }}"
+	ports "github.com/{{ .ProjectName }}/internal/core/ports/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/pkg/configs"
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters"
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination"
+	"github.com/{{ .ProjectName }}/pkg/utils"
+)
+
+type (
+	I{{ .FeatureName }}Handler interface {
+		HandleGet{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+		HandleGet{{ .FeatureName }}s(w http.ResponseWriter, r *http.Request)
+		HandleUpdate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+		HandleCreate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+		HandleDelete{{ .FeatureName }}(w http.ResponseWriter, r *http.Request)
+{{ range .Operations }}		Handle{{ .Name }}(w http.ResponseWriter, r *http.Request)
+{{ end }}	}
+	{{ .FeatureName }}Impl struct {
+		{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service
+	}
+)
+
+func New{{ .FeatureName }}Handler(
+	{{ .FeatureName | ToLower }}Service ports.I{{ .FeatureName }}Service,
+) I{{ .FeatureName }}Handler {
+	return &{{ .FeatureName }}Impl{
+		{{ .FeatureName | ToLower }}Service: {{ .FeatureName | ToLower }}Service,
+	}
+}
+
+// readJSON decodes the JSON body of the request into v.
+func readJSON(r *http.Request, v any) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(v)
+}
+
+// writeJSON writes v as the JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// HandleCreate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleCreate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+	var payload domain.{{ .FeatureName }}Domain
+	if err := readJSON(r, &payload); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Create{{ .FeatureName }}(ctx, payload)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleDelete{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleDelete{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Delete{{ .FeatureName }}(ctx, id)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleUpdate{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleUpdate{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+	var payload domain.{{ .FeatureName }}Domain
+{{ template "parseID" . }}	if err := readJSON(r, &payload); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ if .Validate }}{{ template "validate" . }}{{ end }}	payload.ID = id
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Update{{ .FeatureName }}(ctx, payload)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }} implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}(w http.ResponseWriter, r *http.Request) {
+{{ template "parseID" . }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}(ctx, id)
+	writeJSON(w, http.StatusOK, res)
+}
+
+// HandleGet{{ .FeatureName }}s implements I{{ .FeatureName }}Handler.
+func (h *{{ .FeatureName }}Impl) HandleGet{{ .FeatureName }}s(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(query.Get("page_size"))
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	params := pagination.PaginationParams[filters.{{ .FeatureName }}Filter]{
+		Filters:  filters.{{ .FeatureName }}Filter{ID: query.Get("id")},
+		Sort:     query.Get("sort"),
+		Order:    query.Get("order"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	paramCtx := pagination.SetFilters(ctx, params)
+	res := h.{{ .FeatureName | ToLower }}Service.Get{{ .FeatureName }}s(paramCtx)
+	writeJSON(w, http.StatusOK, res)
+}
+` + StdlibHandlerOperationsTemplate
+
+// StdlibHandlerOperationsTemplate renders the net/http handlers of the operations of a feature besides its
+// CRUD ones.
+var StdlibHandlerOperationsTemplate = `{{ range .Operations }}
+// Handle{{ .Name }} implements I{{ $.FeatureName }}Handler.
+func (h *{{ $.FeatureName }}Impl) Handle{{ .Name }}(w http.ResponseWriter, r *http.Request) {
+{{ if .HasID }}{{ template "parseID" $ }}{{ end }}{{ with .PayloadType $.FeatureName }}	var payload {{ . }}
+	if err := readJSON(r, &payload); err != nil {
+		writeJSON(w, http.StatusBadRequest, utils.APIResponse{StatusCode: configs.API_ERROR_CODE, StatusMessage: "Invalid request payload", Data: err.Error()})
+		return
+	}
+{{ end }}{{ if and (eq .Body "domain") $.Validate }}{{ template "validate" $ }}{{ end }}	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+	res := h.{{ $.FeatureName | ToLower }}Service.{{ .Name }}(ctx{{ .Args }})
+	writeJSON(w, http.StatusOK, res)
+}
+{{ end }}`
+
+// StdlibRouterTemplate renders the RouterImpl the net/http route files add their methods to.
+var StdlibRouterTemplate = `
+package routers
+
+import (
+	"net/http"
+)
+
+type RouterImpl struct {
+	route *http.ServeMux
+}
+
+func NewRoute(route *http.ServeMux) RouterImpl {
+	return RouterImpl{route: route}
+}
+`
+
+var StdlibRouteTemplate = `
+package routers
+
+import (
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+)
+
+func (r RouterImpl) Create{{ .FeatureName }}Routes(h handlers.I{{ .FeatureName }}Handler) {
+{{ range .Operations }}	r.route.HandleFunc("{{ .Method }} /{{ $.Path }}{{ .Path }}", h.Handle{{ .Name }})
+{{ end }}	r.route.HandleFunc("GET /{{ .Path }}", h.HandleGet{{ .FeatureName }}s)
+	r.route.HandleFunc("GET /{{ .Path }}/{id}", h.HandleGet{{ .FeatureName }})
+	r.route.HandleFunc("POST /{{ .Path }}", h.HandleCreate{{ .FeatureName }})
+	r.route.HandleFunc("PUT /{{ .Path }}/{id}", h.HandleUpdate{{ .FeatureName }})
+	r.route.HandleFunc("DELETE /{{ .Path }}/{id}", h.HandleDelete{{ .FeatureName }})
+}
+`
+
+var StdlibAppTemplate = `
+package app
+
+import (
+	"net/http"
+
+	"github.com/{{ .ProjectName }}/internal/adapters/database"
+	handlers "github.com/{{ .ProjectName }}/internal/adapters/http/handlers/{{ .FeatureName | ToLower }}"
+	"github.com/{{ .ProjectName }}/internal/adapters/http/routers"
+	repositories "github.com/{{ .ProjectName }}/internal/adapters/repositories/{{ .FeatureName | ToLower }}"
+	services "github.com/{{ .ProjectName }}/internal/core/services/{{ .FeatureName | ToLower }}"
+	"{{ .DB.Import }}"
+)
+
+func AppContainer(mux *http.ServeMux, db {{ .DB.Type }}) *http.ServeMux {
+	v1 := http.NewServeMux()
+	mux.Handle("/v1/", http.StripPrefix("/v1", v1))
+	route := routers.NewRoute(v1)
+	{{ .FeatureName }}App(route, db)
+	return mux
+}
+
+func {{ .FeatureName }}App(r routers.RouterImpl, db {{ .DB.Type }}) {
+	transactorRepo := database.NewTransactorRepo(db)
+	{{ .FeatureName | ToLower }}Repo := repositories.New{{ .FeatureName }}Repository(db)
+	{{ .FeatureName | ToLower }}Srv := services.New{{ .FeatureName }}Service({{ .FeatureName | ToLower }}Repo, transactorRepo)
+	{{ .FeatureName | ToLower }}Handlers := handlers.New{{ .FeatureName }}Handler({{ .FeatureName | ToLower }}Srv)
+	r.Create{{ .FeatureName }}Routes({{ .FeatureName | ToLower }}Handlers)
+}
+`
@@ -1,5 +1,9 @@
 package domain
 
+type TransactorFlagDomain struct {
+	ProjectName string
+}
+
 var TransactorTemplate = `
 package database
 
@@ -72,7 +76,7 @@ func (d *TransactorImpl) WithinTransaction(ctx context.Context, tFunc func(ctx c
 	// begin transaction
 	tx, err := d.BeginTransaction()
 	if err != nil {
-		return fmt.Errorf("begin transaction: %w", tx.Error)
+		return fmt.Errorf("begin transaction: %w", err)
 	}
 
 	// Ensure that the transaction is finalized properly
@@ -0,0 +1,48 @@
+package domain
+
+type ValidationFlagDomain struct {
+	FeatureName string
+	Imports     []string
+	Patterns    []ValidationPattern
+	Checks      []ValidationCheck
+}
+
+// ValidationPattern is a regular expression compiled once by the validation file.
+type ValidationPattern struct {
+	Name    string // e.g. skuPattern
+	Pattern string // Go string literal of the expression
+}
+
+// ValidationCheck is a constraint of a field checked by Validate.
+type ValidationCheck struct {
+	Failed  string // Go condition true when the constraint is not met
+	Message string // Go string literal of the error
+}
+
+// ValidationFormats maps FieldFormats to the regular expressions checking them.
+var ValidationFormats = map[string]string{
+	"email": `^[^@\s]+@[^@\s]+\.[^@\s]+$`,
+	"uuid":  `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+	"uri":   `^[a-zA-Z][a-zA-Z0-9+.-]*:\S+$`,
+}
+
+// ValidationTemplate renders the Validate method of the domain of a feature whose fields have
+// constraints, which the handlers call on the payloads they bind.
+var ValidationTemplate = `
+package domain
+
+import (
+{{ range .Imports }}	"{{ . }}"
+{{ end }})
+{{ range .Patterns }}
+var {{ .Name }} = regexp.MustCompile({{ .Pattern }})
+{{ end }}
+// Validate reports the fields of d that do not meet their constraints.
+func (d {{ .FeatureName }}Domain) Validate() error {
+	var errs []error
+{{ range .Checks }}	if {{ .Failed }} {
+		errs = append(errs, errors.New({{ .Message }}))
+	}
+{{ end }}	return errors.Join(errs...)
+}
+`
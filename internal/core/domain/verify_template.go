@@ -0,0 +1,240 @@
+package domain
+
+type VerifyFlagDomain struct {
+	FeatureName string
+	ProjectName string
+}
+
+type VerifyIssue struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// VerifyStubs holds minimal declarations of the packages generated code depends on, so a feature
+// can be type-checked without downloading them. Keys are import paths and, like the sources,
+// are rendered with VerifyFlagDomain. Only what the templates use is declared.
+var VerifyStubs = map[string]string{
+	"github.com/gofiber/fiber/v2":                          FiberStub,
+	"gorm.io/gorm":                                         GormStub,
+	"github.com/{{ .ProjectName }}/pkg/configs":            ConfigsStub,
+	"github.com/{{ .ProjectName }}/pkg/utils":              UtilsStub,
+	"github.com/{{ .ProjectName }}/pkg/helpers/filters":    FiltersStub,
+	"github.com/{{ .ProjectName }}/pkg/helpers/pagination": PaginationStub,
+
+	"google.golang.org/grpc":                             GRPCStub,
+	"google.golang.org/grpc/codes":                       GRPCCodesStub,
+	"google.golang.org/grpc/status":                      GRPCStatusStub,
+	"google.golang.org/protobuf/types/known/timestamppb": TimestampStub,
+	"connectrpc.com/connect":                             ConnectStub,
+}
+
+// HTTPVerifyStubs adds, per -http framework, the stubs of the framework and, when gohexa does not
+// generate it, of the routers package. The standard library needs none.
+var HTTPVerifyStubs = map[string]map[string]string{
+	"fiber": {"github.com/{{ .ProjectName }}/internal/adapters/http/routers": RoutersStub},
+	"gin":   {"github.com/gin-gonic/gin": GinStub},
+	"echo":  {"github.com/labstack/echo/v4": EchoStub},
+	"chi":   {"github.com/go-chi/chi/v5": ChiStub},
+}
+
+// ORMVerifyStubs adds, per -orm library and for -db mongo, the stubs of the library. GORM is always stubbed since the
+// pagination helpers of the project template depend on it.
+var ORMVerifyStubs = map[string]map[string]string{
+	"sqlx": {
+		"github.com/jmoiron/sqlx": SqlxStub,
+		"github.com/google/uuid":  UUIDStub,
+	},
+	"pgx": {
+		"github.com/jackc/pgx/v5":         PgxStub,
+		"github.com/jackc/pgx/v5/pgconn":  PgconnStub,
+		"github.com/jackc/pgx/v5/pgxpool": PgxpoolStub,
+	},
+	"sqlc": {
+		"github.com/jmoiron/sqlx": SqlxStub,
+		"github.com/google/uuid":  UUIDStub,
+	},
+	"ent": EntStubs,
+	"bun": {"github.com/uptrace/bun": BunStub},
+
+	"mongo": MongoStubs,
+}
+
+var FiberStub = `
+package fiber
+
+import "context"
+
+type Ctx struct{}
+
+func (c *Ctx) BodyParser(out interface{}) error                      { return nil }
+func (c *Ctx) Params(key string, defaultValue ...string) string      { return "" }
+func (c *Ctx) Query(key string, defaultValue ...string) string       { return "" }
+func (c *Ctx) JSON(data interface{}, ctype ...string) error          { return nil }
+func (c *Ctx) Status(status int) *Ctx                                { return c }
+func (c *Ctx) Context() context.Context                              { return context.Background() }
+func (c *Ctx) UserContext() context.Context                          { return context.Background() }
+
+type Handler = func(*Ctx) error
+
+type Router interface {
+	Use(args ...interface{}) Router
+	Get(path string, handlers ...Handler) Router
+	Post(path string, handlers ...Handler) Router
+	Put(path string, handlers ...Handler) Router
+	Patch(path string, handlers ...Handler) Router
+	Delete(path string, handlers ...Handler) Router
+	Group(prefix string, handlers ...Handler) Router
+}
+
+type Config struct{}
+
+type App struct{ Router }
+
+func New(config ...Config) *App         { return &App{} }
+func (app *App) Listen(addr string) error { return nil }
+
+type Map map[string]interface{}
+`
+
+var GormStub = `
+package gorm
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type DB struct {
+	Error        error
+	RowsAffected int64
+}
+
+func (db *DB) WithContext(ctx context.Context) *DB                            { return db }
+func (db *DB) Begin(opts ...*sql.TxOptions) *DB                               { return db }
+func (db *DB) Commit() *DB                                                    { return db }
+func (db *DB) Rollback() *DB                                                  { return db }
+func (db *DB) Transaction(fc func(tx *DB) error, opts ...*sql.TxOptions) error { return nil }
+func (db *DB) Model(value interface{}) *DB                                    { return db }
+func (db *DB) Where(query interface{}, args ...interface{}) *DB               { return db }
+func (db *DB) Order(value interface{}) *DB                                    { return db }
+func (db *DB) Limit(limit int) *DB                                            { return db }
+func (db *DB) Offset(offset int) *DB                                          { return db }
+func (db *DB) Count(count *int64) *DB                                         { return db }
+func (db *DB) First(dest interface{}, conds ...interface{}) *DB               { return db }
+func (db *DB) Find(dest interface{}, conds ...interface{}) *DB                { return db }
+func (db *DB) Create(value interface{}) *DB                                   { return db }
+func (db *DB) Save(value interface{}) *DB                                     { return db }
+func (db *DB) Delete(value interface{}, conds ...interface{}) *DB             { return db }
+func (db *DB) AutoMigrate(dst ...interface{}) error                           { return nil }
+
+type Model struct {
+	ID        uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt DeletedAt
+}
+
+type DeletedAt sql.NullTime
+`
+
+var ConfigsStub = `
+package configs
+
+const (
+	API_SUCCESS_CODE = "0000"
+	API_ERROR_CODE   = "9999"
+)
+`
+
+var UtilsStub = `
+package utils
+
+import "github.com/gofiber/fiber/v2"
+
+type APIResponse struct {
+	StatusCode    string      ` + "`json:\"status_code\"`" + `
+	StatusMessage string      ` + "`json:\"status_message\"`" + `
+	Data          interface{} ` + "`json:\"data\"`" + `
+}
+
+func NewErrorResponse(c *fiber.Ctx, message string, err interface{}) error { return nil }
+
+func ConvertSlice[T any, U any](in []T, convert func(T) U) []U {
+	out := make([]U, 0, len(in))
+	for _, v := range in {
+		out = append(out, convert(v))
+	}
+	return out
+}
+`
+
+var FiltersStub = `
+package filters
+
+type {{ .FeatureName }}Filter struct {
+	ID string ` + "`query:\"id\"`" + `
+}
+`
+
+var PaginationStub = `
+package pagination
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type Links struct {
+	Next     string
+	Previous string
+}
+
+type Pagination[T any] struct {
+	Rows       T
+	Links      Links
+	Total      int64
+	Page       int
+	PageSize   int
+	TotalPages int
+}
+
+type PaginationParams[F any] struct {
+	Filters  F
+	Sort     string
+	Order    string
+	Page     int
+	PageSize int
+}
+
+type SortParams struct {
+	Sort           string
+	Order          string
+	DefaultOrderBy string
+}
+
+func NewPaginationParams[F any](c *fiber.Ctx) PaginationParams[F]                          { return PaginationParams[F]{} }
+func SetFilters[F any](ctx context.Context, p PaginationParams[F]) context.Context        { return ctx }
+func GetFilters[F any](ctx context.Context) PaginationParams[F]                            { return PaginationParams[F]{} }
+func NewOrderBy(p SortParams) string                                                       { return "" }
+func ApplyFilter(db *gorm.DB, column string, value interface{}, operator string) *gorm.DB { return db }
+func Paginate[F any, T any](p PaginationParams[F], db *gorm.DB) (Pagination[T], error)    { return Pagination[T]{}, nil }
+`
+
+var RoutersStub = `
+package routers
+
+import "github.com/gofiber/fiber/v2"
+
+type RouterImpl struct {
+	route fiber.Router
+}
+
+func NewRoute(route fiber.Router) RouterImpl {
+	return RouterImpl{route: route}
+}
+`
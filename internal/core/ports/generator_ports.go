@@ -1,5 +1,7 @@
 package ports
 
+import "github.com/rapidstellar/gohexa/internal/core/domain"
+
 type IGeneratorService interface {
 	CreateProject(name, templateName string)
 	GenerateAppFile(dir string)
@@ -11,4 +13,10 @@ type IGeneratorService interface {
 	GenerateRouteFile(dir string)
 	GenerateServiceFile(dir string)
 	GenerateTransactorFile(dir string)
+	GenerateGRPCFiles(dir string)
+	GenerateGraphQLFiles(dir string)
+	GenerateMigrationFiles(dir string)
+	GenerateFeatureLayers(root string, layers []string)
+	RecordSchema()
+	VerifyFeature() ([]domain.VerifyIssue, error)
 }
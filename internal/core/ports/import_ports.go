@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// ISchemaIntrospector reads the tables of an existing database.
+type ISchemaIntrospector interface {
+	Dialect() string // one of domain.Databases
+	Tables(ctx context.Context) ([]domain.SchemaTable, error)
+	Close() error
+}
+
+type IImportService interface {
+	Features(tables []domain.SchemaTable) ([]domain.FeatureSpec, []string)
+	ParseDDL(ddl string) ([]domain.SchemaTable, string, error)
+	ParseOpenAPI(spec []byte) ([]domain.FeatureSpec, []string, error)
+	ParseStruct(filename string, src []byte, typeName string) (domain.FeatureSpec, []string, error)
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "github.com/rapidstellar/gohexa/internal/core/domain"
+
+type IInspectorService interface {
+	ListFeatures(root string) ([]domain.FeatureInventory, error)
+	Lint(root string) ([]domain.LintIssue, error)
+	Diagnose(root string) ([]domain.Diagnostic, error)
+}
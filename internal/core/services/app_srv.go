@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +10,17 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// appTemplate returns the template key, source and data of the app file.
+func (g *GeneratorServiceImpls) appTemplate() (string, string, any) {
+	data := domain.AppFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		DB:          g.dbHandle(),
+	}
+	key, text := g.httpTemplate("app")
+	return key, text, data
+}
+
 // GenerateAppFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateAppFile(dir string) {
 	// Define the template for the app file
@@ -20,16 +30,9 @@ func (g *GeneratorServiceImpls) GenerateAppFile(dir string) {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
 
-	// Prepare the data for template rendering
-	data := domain.AppFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-	}
-
-	// Parse and execute the template
-	tmpl, err := template.New("app").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.AppTemplate)
+	// Render the template
+	templateKey, templateText, data := g.appTemplate()
+	content, err := renderTemplate("app", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -39,19 +42,11 @@ func (g *GeneratorServiceImpls) GenerateAppFile(dir string) {
 	fileName := fmt.Sprintf("%s_app.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("App file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("App file '%s' created successfully!\n", filePath)
+	g.recordLayer("app", templateKey, filePath)
 }
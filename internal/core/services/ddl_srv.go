@@ -0,0 +1,464 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// ddlToken is a token of SQL DDL. Quoted identifiers are words without their quotes, string
+// literals keep their quotes.
+type ddlToken struct {
+	text  string
+	word  bool // an identifier, keyword, number or literal, as opposed to punctuation
+	ident bool // a quoted identifier, which is never a keyword
+}
+
+// keyword reports whether t is the unquoted keyword kw, in any case.
+func (t ddlToken) keyword(kw string) bool {
+	return t.word && !t.ident && strings.EqualFold(t.text, kw)
+}
+
+// ddlColumnKeywords start the constraints of a column definition, which end its type and default.
+var ddlColumnKeywords = []string{"NOT", "NULL", "DEFAULT", "PRIMARY", "REFERENCES", "UNIQUE", "CHECK", "CONSTRAINT",
+	"AUTO_INCREMENT", "AUTOINCREMENT", "GENERATED", "COLLATE", "COMMENT", "ON", "KEY", "CHARSET"}
+
+// ParseDDL implements ports.IImportService. It reads the CREATE TABLE statements of ddl with
+// their columns, nullability, defaults and primary and foreign keys, as well as the single column
+// indexes of CREATE INDEX and the constraints added by ALTER TABLE, as pg_dump writes them. The
+// dialect is mysql when ddl has backquotes, AUTO_INCREMENT, ENGINE or UNSIGNED, else postgres.
+func (s *ImportServiceImpls) ParseDDL(ddl string) ([]domain.SchemaTable, string, error) {
+	tokens, dialect, err := lexDDL(ddl)
+	if err != nil {
+		return nil, "", err
+	}
+	var tables []*domain.SchemaTable
+	find := func(name string) *domain.SchemaTable {
+		for _, t := range tables {
+			if t.Name == name {
+				return t
+			}
+		}
+		return nil
+	}
+	for _, stmt := range splitDDL(tokens, ";") {
+		if len(stmt) < 2 {
+			continue
+		}
+		switch {
+		case stmt[0].keyword("CREATE"):
+			rest := skipKeywords(stmt[1:], "OR", "REPLACE", "GLOBAL", "LOCAL", "TEMPORARY", "TEMP", "UNLOGGED")
+			if len(rest) > 0 && rest[0].keyword("TABLE") {
+				table, err := parseCreateTable(skipKeywords(rest[1:], "IF", "NOT", "EXISTS"))
+				if err != nil {
+					return nil, "", err
+				}
+				if table != nil {
+					tables = append(tables, table)
+				}
+				continue
+			}
+			unique := len(rest) > 0 && rest[0].keyword("UNIQUE")
+			if rest = skipKeywords(rest, "UNIQUE"); len(rest) > 0 && rest[0].keyword("INDEX") {
+				name, column := parseCreateIndex(rest[1:])
+				if t := find(name); t != nil && column != "" {
+					index := "index"
+					if unique {
+						index = "unique"
+					}
+					setColumn(t, column, func(c *domain.SchemaColumn) { c.Index = index })
+				}
+			}
+		case stmt[0].keyword("ALTER") && stmt[1].keyword("TABLE"):
+			rest := skipKeywords(stmt[2:], "IF", "EXISTS", "ONLY")
+			name, rest := parseName(rest)
+			t := find(name)
+			if t == nil {
+				continue
+			}
+			for _, action := range splitDDL(rest, ",") {
+				if len(action) == 0 || !action[0].keyword("ADD") {
+					continue
+				}
+				parseTableItem(t, skipKeywords(action[1:], "COLUMN"))
+			}
+		}
+	}
+	if len(tables) == 0 {
+		return nil, "", fmt.Errorf("no CREATE TABLE statement found")
+	}
+	result := make([]domain.SchemaTable, len(tables))
+	for i, t := range tables {
+		result[i] = *t
+	}
+	return result, dialect, nil
+}
+
+// lexDDL splits ddl into tokens, without its comments, and detects its dialect.
+func lexDDL(ddl string) ([]ddlToken, string, error) {
+	var tokens []ddlToken
+	dialect := "postgres"
+	for i := 0; i < len(ddl); {
+		c := ddl[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.HasPrefix(ddl[i:], "--") || c == '#':
+			end := strings.IndexByte(ddl[i:], '\n')
+			if end < 0 {
+				end = len(ddl) - i
+			}
+			i += end
+		case strings.HasPrefix(ddl[i:], "/*"):
+			end := strings.Index(ddl[i+2:], "*/")
+			if end < 0 {
+				return nil, "", fmt.Errorf("unterminated comment")
+			}
+			i += end + 4
+		case c == '\'' || c == '"' || c == '`':
+			end := i + 1
+			for ; end < len(ddl); end++ {
+				if ddl[end] == '\\' && c == '\'' {
+					end++
+					continue
+				}
+				if ddl[end] == c {
+					if end+1 < len(ddl) && ddl[end+1] == c {
+						end++ // an escaped quote
+						continue
+					}
+					break
+				}
+			}
+			if end >= len(ddl) {
+				return nil, "", fmt.Errorf("unterminated quote %c", c)
+			}
+			if c == '\'' {
+				tokens = append(tokens, ddlToken{text: ddl[i : end+1], word: true})
+			} else {
+				tokens = append(tokens, ddlToken{text: ddl[i+1 : end], word: true, ident: true})
+			}
+			if c == '`' {
+				dialect = "mysql"
+			}
+			i = end + 1
+		case isDDLWordByte(c):
+			end := i
+			for end < len(ddl) && isDDLWordByte(ddl[end]) {
+				end++
+			}
+			word := ddl[i:end]
+			switch strings.ToUpper(word) {
+			case "AUTO_INCREMENT", "ENGINE", "UNSIGNED":
+				dialect = "mysql"
+			}
+			tokens = append(tokens, ddlToken{text: word, word: true})
+			i = end
+		case strings.HasPrefix(ddl[i:], "::"):
+			tokens = append(tokens, ddlToken{text: "::"})
+			i += 2
+		default:
+			tokens = append(tokens, ddlToken{text: string(c)})
+			i++
+		}
+	}
+	return tokens, dialect, nil
+}
+
+func isDDLWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// splitDDL splits tokens at the separator sep outside of parentheses.
+func splitDDL(tokens []ddlToken, sep string) [][]ddlToken {
+	var parts [][]ddlToken
+	depth, start := 0, 0
+	for i, t := range tokens {
+		switch {
+		case t.ident:
+		case t.text == "(":
+			depth++
+		case t.text == ")":
+			depth--
+		case t.text == sep && depth == 0:
+			parts = append(parts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, tokens[start:])
+}
+
+// group returns the tokens inside the parentheses tokens starts with, and the tokens after them.
+func group(tokens []ddlToken) ([]ddlToken, []ddlToken, bool) {
+	if len(tokens) == 0 || tokens[0].ident || tokens[0].text != "(" {
+		return nil, tokens, false
+	}
+	depth := 0
+	for i, t := range tokens {
+		if t.ident {
+			continue
+		}
+		switch t.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return tokens[1:i], tokens[i+1:], true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
+// skipKeywords drops the keywords in kws from the start of tokens.
+func skipKeywords(tokens []ddlToken, kws ...string) []ddlToken {
+	for len(tokens) > 0 {
+		skipped := false
+		for _, kw := range kws {
+			if tokens[0].keyword(kw) {
+				tokens, skipped = tokens[1:], true
+				break
+			}
+		}
+		if !skipped {
+			break
+		}
+	}
+	return tokens
+}
+
+// parseName reads a possibly schema-qualified name, e.g. public.orders, and returns it without its
+// schema.
+func parseName(tokens []ddlToken) (string, []ddlToken) {
+	if len(tokens) == 0 || !tokens[0].word {
+		return "", tokens
+	}
+	name := tokens[0].text
+	tokens = tokens[1:]
+	for len(tokens) > 1 && tokens[0].text == "." && !tokens[0].ident && tokens[1].word {
+		name = tokens[1].text
+		tokens = tokens[2:]
+	}
+	return name, tokens
+}
+
+// renderDDL writes tokens back as SQL, e.g. numeric(10,2) or 'new'::character varying.
+func renderDDL(tokens []ddlToken) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 {
+			prev := tokens[i-1]
+			if t.word && (prev.word || prev.text == ")" && !prev.ident) {
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+// parseCreateTable reads the name and the definitions of a CREATE TABLE statement after IF NOT
+// EXISTS. CREATE TABLE ... AS and LIKE have no definitions and yield nil.
+func parseCreateTable(tokens []ddlToken) (*domain.SchemaTable, error) {
+	name, tokens := parseName(tokens)
+	if name == "" {
+		return nil, fmt.Errorf("CREATE TABLE without a name")
+	}
+	body, _, ok := group(tokens)
+	if !ok {
+		return nil, nil
+	}
+	table := &domain.SchemaTable{Name: name}
+	for _, item := range splitDDL(body, ",") {
+		parseTableItem(table, item)
+	}
+	return table, nil
+}
+
+// parseCreateIndex reads the table and the column of a CREATE INDEX statement after INDEX. The
+// column is empty for indexes of several columns or of expressions.
+func parseCreateIndex(tokens []ddlToken) (string, string) {
+	for len(tokens) > 0 && !tokens[0].keyword("ON") {
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return "", ""
+	}
+	table, tokens := parseName(skipKeywords(tokens[1:], "ONLY"))
+	if len(tokens) > 1 && tokens[0].keyword("USING") {
+		tokens = tokens[2:]
+	}
+	columns, _, _ := group(tokens)
+	return table, singleColumn(columns)
+}
+
+// singleColumn returns the column of a parenthesised column list, e.g. (customer_id) or
+// (email(100) DESC), or "" when it has several or is an expression.
+func singleColumn(columns []ddlToken) string {
+	if len(columns) == 0 || !columns[0].word || len(splitDDL(columns, ",")) != 1 {
+		return ""
+	}
+	// email(100) is a prefix of the column in MySQL, lower(email) an expression.
+	if inner, _, ok := group(columns[1:]); ok {
+		if _, err := strconv.Atoi(renderDDL(inner)); err != nil {
+			return ""
+		}
+	}
+	return columns[0].text
+}
+
+// parseTableItem adds the column or applies the table constraint defined by item to table.
+func parseTableItem(table *domain.SchemaTable, item []ddlToken) {
+	if len(item) == 0 {
+		return
+	}
+	if item[0].keyword("CONSTRAINT") {
+		if len(item) < 2 {
+			return
+		}
+		item = item[2:]
+		if len(item) == 0 {
+			return
+		}
+	}
+	switch {
+	case item[0].keyword("PRIMARY"):
+		columns, _, _ := group(skipKeywords(item[1:], "KEY"))
+		for _, column := range splitDDL(columns, ",") {
+			if len(column) > 0 {
+				setColumn(table, column[0].text, func(c *domain.SchemaColumn) { c.PrimaryKey, c.Nullable = true, false })
+			}
+		}
+	case item[0].keyword("FOREIGN"):
+		columns, rest, _ := group(skipKeywords(item[1:], "KEY"))
+		if column := singleColumn(columns); column != "" && len(rest) > 0 && rest[0].keyword("REFERENCES") {
+			if target := references(rest[1:]); target != "" {
+				setColumn(table, column, func(c *domain.SchemaColumn) { c.References = target })
+			}
+		}
+	case item[0].keyword("UNIQUE"), item[0].keyword("KEY"), item[0].keyword("INDEX"):
+		index := "index"
+		if item[0].keyword("UNIQUE") {
+			index = "unique"
+		}
+		rest := skipKeywords(item[1:], "KEY", "INDEX")
+		if len(rest) > 0 && rest[0].word {
+			rest = rest[1:] // the name of the index
+		}
+		columns, _, _ := group(rest)
+		if column := singleColumn(columns); column != "" {
+			setColumn(table, column, func(c *domain.SchemaColumn) {
+				if c.Index != "unique" {
+					c.Index = index
+				}
+			})
+		}
+	case item[0].keyword("CHECK"), item[0].keyword("EXCLUDE"), item[0].keyword("FULLTEXT"),
+		item[0].keyword("SPATIAL"), item[0].keyword("LIKE"), item[0].keyword("PERIOD"):
+	default:
+		if column, ok := parseColumn(item); ok {
+			table.Columns = append(table.Columns, column)
+		}
+	}
+}
+
+// parseColumn reads a column definition: its name, its type and its constraints.
+func parseColumn(item []ddlToken) (domain.SchemaColumn, bool) {
+	if len(item) < 2 || !item[0].word {
+		return domain.SchemaColumn{}, false
+	}
+	column := domain.SchemaColumn{Name: item[0].text, Nullable: true}
+	rest := item[1:]
+	typeEnd := 0
+	for typeEnd < len(rest) && !isColumnKeyword(rest, typeEnd) {
+		if _, after, ok := group(rest[typeEnd:]); ok {
+			typeEnd = len(rest) - len(after)
+			continue
+		}
+		typeEnd++
+	}
+	column.Type = renderDDL(rest[:typeEnd])
+	rest = rest[typeEnd:]
+
+	for len(rest) > 0 {
+		switch {
+		case rest[0].keyword("NOT") && len(rest) > 1 && rest[1].keyword("NULL"):
+			column.Nullable = false
+			rest = rest[2:]
+		case rest[0].keyword("NULL"):
+			column.Nullable = true
+			rest = rest[1:]
+		case rest[0].keyword("DEFAULT"):
+			end := 1
+			for end < len(rest) && !isColumnKeyword(rest, end) {
+				if _, after, ok := group(rest[end:]); ok {
+					end = len(rest) - len(after)
+					continue
+				}
+				end++
+			}
+			column.Default = renderDDL(rest[1:end])
+			rest = rest[end:]
+		case rest[0].keyword("PRIMARY"):
+			column.PrimaryKey, column.Nullable = true, false
+			rest = skipKeywords(rest[1:], "KEY")
+		case rest[0].keyword("UNIQUE"):
+			column.Index = "unique"
+			rest = skipKeywords(rest[1:], "KEY")
+		case rest[0].keyword("REFERENCES"):
+			column.References = references(rest[1:])
+			_, rest = parseName(rest[1:])
+		case rest[0].keyword("ON") && len(rest) > 2:
+			// ON DELETE SET NULL, ON UPDATE CASCADE or ON UPDATE CURRENT_TIMESTAMP
+			rest = rest[2:]
+			if rest[0].keyword("SET") || rest[0].keyword("NO") {
+				rest = rest[1:]
+			}
+			rest = rest[1:]
+		default:
+			// other constraints, e.g. CHECK (...) or GENERATED ALWAYS AS IDENTITY
+			rest = rest[1:]
+		}
+		if _, after, ok := group(rest); ok {
+			rest = after // the arguments of the constraint, e.g. (id) of REFERENCES customers (id)
+		}
+	}
+	return column, true
+}
+
+// isColumnKeyword reports whether tokens[i] starts a constraint of a column definition. CHARACTER
+// does only when it is MySQL's CHARACTER SET, as opposed to character varying.
+func isColumnKeyword(tokens []ddlToken, i int) bool {
+	t := tokens[i]
+	if t.keyword("CHARACTER") {
+		return i+1 < len(tokens) && tokens[i+1].keyword("SET")
+	}
+	for _, kw := range ddlColumnKeywords {
+		if t.keyword(kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// references returns the table after REFERENCES when the reference is to its id, or to its
+// primary key without naming a column.
+func references(tokens []ddlToken) string {
+	target, rest := parseName(tokens)
+	if columns, _, ok := group(rest); ok && singleColumn(columns) != "id" {
+		return ""
+	}
+	return target
+}
+
+// setColumn applies set to the column name of table, if it has one.
+func setColumn(table *domain.SchemaTable, name string, set func(c *domain.SchemaColumn)) {
+	for i := range table.Columns {
+		if table.Columns[i].Name == name {
+			set(&table.Columns[i])
+		}
+	}
+}
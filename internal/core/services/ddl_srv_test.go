@@ -0,0 +1,71 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+func TestParseDDL(t *testing.T) {
+	mysql := "CREATE TABLE `customers` (\n" +
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `email` varchar(255) NOT NULL,\n" +
+		"  `vip` tinyint(1) DEFAULT '0',\n" +
+		"  `joined_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  UNIQUE KEY `uq_email` (`email`(100))\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4; -- customers\n" +
+		"CREATE TABLE `orders` (\n" +
+		"  `id` bigint unsigned NOT NULL AUTO_INCREMENT PRIMARY KEY,\n" +
+		"  `customer_id` bigint unsigned NULL,\n" +
+		"  CONSTRAINT `fk_customer` FOREIGN KEY (`customer_id`) REFERENCES `customers` (`id`) ON DELETE SET NULL\n" +
+		");"
+	tables, dialect, err := NewImportService().ParseDDL(mysql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dialect != "mysql" {
+		t.Errorf("dialect = %s, want mysql", dialect)
+	}
+	specs, _ := NewImportService().Features(tables)
+	want := []domain.FeatureSpec{
+		{Feature: "Customer", Table: "customers", Fields: "email:string:unique,vip:bool:null:default=FALSE,joined_at:time.Time:default=CURRENT_TIMESTAMP"},
+		{Feature: "Order", Table: "orders", Fields: "customer_id:uint64:ref=customers:null"},
+	}
+	if len(specs) != len(want) {
+		t.Fatalf("got specs %+v, want %+v", specs, want)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(specs[i], want[i]) {
+			t.Errorf("spec %d = %+v, want %+v", i, specs[i], want[i])
+		}
+	}
+
+	// pg_dump adds the keys after the tables.
+	postgres := `CREATE TABLE public.invoices (
+    id integer NOT NULL,
+    "number" character varying(20) DEFAULT 'draft'::character varying NOT NULL,
+    total numeric(10,2),
+    customer_id integer
+);
+ALTER TABLE ONLY public.invoices ADD CONSTRAINT invoices_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.invoices
+    ADD CONSTRAINT invoices_customer_id_fkey FOREIGN KEY (customer_id) REFERENCES public.customers(id);
+CREATE INDEX invoices_total_idx ON public.invoices USING btree (total);`
+	tables, dialect, err = NewImportService().ParseDDL(postgres)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dialect != "postgres" {
+		t.Errorf("dialect = %s, want postgres", dialect)
+	}
+	specs, _ = NewImportService().Features(tables)
+	if len(specs) != 1 || specs[0].Fields != "number:string:default='draft',total:float64:index:null,customer_id:int32:ref=customers:null" {
+		t.Errorf("got specs %+v", specs)
+	}
+
+	if _, _, err := NewImportService().ParseDDL("SELECT 1;"); err == nil {
+		t.Error("ParseDDL without CREATE TABLE succeeded, want an error")
+	}
+}
@@ -0,0 +1,484 @@
+package services
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// Diagnose implements ports.IInspectorService.
+func (s *InspectorServiceImpls) Diagnose(root string) ([]domain.Diagnostic, error) {
+	modulePath, err := utils.ReadModulePath(root)
+	if err != nil {
+		return nil, err
+	}
+	m, err := loadManifest(root)
+	if err != nil {
+		return nil, err
+	}
+	layout := domain.DefaultLayout
+	if m.Layout != nil {
+		layout = *m.Layout
+	}
+	fset, files, _, err := parseProject(root)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &doctor{root: root, modulePath: modulePath, layout: layout, fset: fset}
+	for _, f := range files {
+		d.collect(f, func(pos ast.Node) (string, int) {
+			p := fset.Position(pos.Pos())
+			return p.Filename, p.Line
+		})
+	}
+	d.typeCheck(files)
+	d.checkAppContainers()
+	d.checkRedeclarations()
+	d.checkPortImplementations()
+	d.checkRouteRegistrations()
+	d.checkIDTypes()
+
+	sort.SliceStable(d.diagnostics, func(i, j int) bool {
+		if d.diagnostics[i].File != d.diagnostics[j].File {
+			return d.diagnostics[i].File < d.diagnostics[j].File
+		}
+		return d.diagnostics[i].Line < d.diagnostics[j].Line
+	})
+	return d.diagnostics, nil
+}
+
+// declaration is where something of interest was found.
+type declaration struct {
+	name string
+	file string
+	line int
+}
+
+// doctor holds what Diagnose collects from the project before running its checks.
+type doctor struct {
+	root       string
+	modulePath string
+	layout     domain.Layout
+	fset       *token.FileSet
+
+	appContainers []declaration             // AppContainer, GRPCContainer and ConnectContainer
+	packages      map[string]*types.Package // type-checked packages, by directory relative to root
+	redeclared    []redeclaration
+	routeDefs     map[string]declaration
+	routeCalls    []declaration
+	idTypes       map[string][]declaration // "<layer> <type>" entries, by feature
+
+	diagnostics []domain.Diagnostic
+}
+
+func (d *doctor) report(file string, line int, check, message, fix string) {
+	d.diagnostics = append(d.diagnostics, domain.Diagnostic{File: file, Line: line, Check: check, Message: message, Fix: fix})
+}
+
+// collect records the declarations of one file and checks its imports against the module path.
+func (d *doctor) collect(f sourceFile, position func(ast.Node) (string, int)) {
+	if d.routeDefs == nil {
+		d.routeDefs = map[string]declaration{}
+		d.idTypes = map[string][]declaration{}
+	}
+
+	for _, imp := range f.file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		d.checkImport(path, imp, position)
+	}
+
+	tableNames := map[string]bool{}
+	for _, decl := range f.file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok {
+			for _, spec := range gen.Specs {
+				if vs, ok := spec.(*ast.ValueSpec); ok {
+					for _, ident := range vs.Names {
+						if strings.HasPrefix(ident.Name, "TN") {
+							tableNames[strings.TrimPrefix(ident.Name, "TN")] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, decl := range f.file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			file, line := position(decl)
+			name := decl.Name.Name
+			if decl.Recv == nil {
+				if _, ok := containerApps[name]; ok {
+					d.appContainers = append(d.appContainers, declaration{name: name, file: file, line: line})
+				}
+				continue
+			}
+			if isRouteRegistration(name) {
+				d.routeDefs[name] = declaration{name: name, file: file, line: line}
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				name := ts.Name.Name
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					if strings.HasPrefix(name, "I") && strings.HasSuffix(name, "Repository") {
+						feature := strings.TrimSuffix(strings.TrimPrefix(name, "I"), "Repository")
+						for _, field := range t.Methods.List {
+							fn, ok := field.Type.(*ast.FuncType)
+							if !ok || len(field.Names) == 0 || field.Names[0].Name != "Get"+feature {
+								continue
+							}
+							if params := flattenParams(fn.Params); len(params) > 1 {
+								pf, pl := position(params[1])
+								d.idTypes[feature] = append(d.idTypes[feature], declaration{
+									name: "port " + types.ExprString(params[1]), file: pf, line: pl,
+								})
+							}
+						}
+					}
+				case *ast.StructType:
+					feature, kind := "", ""
+					switch {
+					case strings.HasSuffix(name, "Domain") && name != "Domain":
+						feature, kind = strings.TrimSuffix(name, "Domain"), "domain"
+					case tableNames[name]:
+						feature, kind = name, "model"
+					}
+					if feature == "" {
+						continue
+					}
+					for _, field := range t.Fields.List {
+						for _, ident := range field.Names {
+							if ident.Name == "ID" {
+								ff, fl := position(field)
+								d.idTypes[feature] = append(d.idTypes[feature], declaration{
+									name: kind + " " + types.ExprString(field.Type), file: ff, line: fl,
+								})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	ast.Inspect(f.file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isRouteRegistration(sel.Sel.Name) {
+			file, line := position(call)
+			d.routeCalls = append(d.routeCalls, declaration{name: sel.Sel.Name, file: file, line: line})
+		}
+		return true
+	})
+}
+
+// checkImport reports project imports that do not start with the module path of go.mod,
+// which happens when -project did not match the module.
+func (d *doctor) checkImport(path string, imp *ast.ImportSpec, position func(ast.Node) (string, int)) {
+	rel, ok := projectImport(d.root, d.modulePath, path)
+	if !ok {
+		return
+	}
+	file, line := position(imp)
+	d.report(file, line, "module-path",
+		fmt.Sprintf("import %q does not match module %q of go.mod", path, d.modulePath),
+		fmt.Sprintf("replace it with %q, or regenerate with -project matching the module path", d.modulePath+"/"+rel))
+}
+
+// typeCheck type-checks the packages of the project, other than its tests, into d.packages.
+// Packages outside the module and the standard library are not resolved, and type errors are
+// ignored: the invalid types they leave are identical to each other.
+func (d *doctor) typeCheck(files []sourceFile) {
+	imp := &projectImporter{fset: d.fset, modulePath: d.modulePath, files: map[string][]*ast.File{}, checked: map[string]*types.Package{}}
+	for _, f := range files {
+		if strings.HasSuffix(f.path, "_test.go") {
+			continue
+		}
+		rel, _ := filepath.Rel(d.root, filepath.Dir(f.path))
+		rel = filepath.ToSlash(rel)
+		imp.files[rel] = append(imp.files[rel], f.file)
+	}
+	verifyMu.Lock()
+	defer verifyMu.Unlock()
+	for rel := range imp.files {
+		imp.check(rel)
+	}
+	d.packages = imp.checked
+	d.redeclared = imp.redeclared
+}
+
+// redeclaration is a name declared twice in a package, as reported by the type checker.
+type redeclaration struct {
+	name       string
+	pos, other token.Position
+}
+
+// projectImporter type-checks the packages of a project from their parsed files, importing the
+// standard library from source.
+type projectImporter struct {
+	fset       *token.FileSet
+	modulePath string
+	files      map[string][]*ast.File    // by package directory, relative to the root
+	checked    map[string]*types.Package // by package directory; nil while it is being checked
+	redeclared []redeclaration
+}
+
+// Import implements types.Importer.
+func (p *projectImporter) Import(pkgPath string) (*types.Package, error) {
+	rel, ok := ".", pkgPath == p.modulePath
+	if !ok {
+		rel, ok = strings.CutPrefix(pkgPath, p.modulePath+"/")
+	}
+	if !ok {
+		if strings.Contains(strings.Split(pkgPath, "/")[0], ".") {
+			return nil, fmt.Errorf("package %s is outside the module", pkgPath)
+		}
+		return stdlib().Import(pkgPath)
+	}
+	if _, ok := p.files[rel]; !ok {
+		return nil, fmt.Errorf("package %s not found", pkgPath)
+	}
+	if pkg := p.check(rel); pkg != nil {
+		return pkg, nil
+	}
+	return nil, fmt.Errorf("import cycle through %s", pkgPath)
+}
+
+// check type-checks the package in the directory rel once.
+func (p *projectImporter) check(rel string) *types.Package {
+	if pkg, ok := p.checked[rel]; ok {
+		return pkg
+	}
+	p.checked[rel] = nil
+	pkgPath := p.modulePath
+	if rel != "." {
+		pkgPath += "/" + rel
+	}
+	conf := types.Config{Importer: p, Error: p.addError}
+	pkg, _ := conf.Check(pkgPath, p.fset, p.files[rel], nil)
+	p.checked[rel] = pkg
+	return pkg
+}
+
+// addError keeps the redeclarations among the type errors, pairing each with the position of the
+// other declaration the type checker reports next. The other errors are ignored.
+func (p *projectImporter) addError(err error) {
+	e, ok := err.(types.Error)
+	if !ok {
+		return
+	}
+	if name, ok := strings.CutSuffix(e.Msg, " redeclared in this block"); ok {
+		p.redeclared = append(p.redeclared, redeclaration{name: name, pos: p.fset.Position(e.Pos)})
+		return
+	}
+	if name, ok := strings.CutPrefix(e.Msg, "\tother declaration of "); ok && len(p.redeclared) > 0 {
+		if last := &p.redeclared[len(p.redeclared)-1]; last.name == name {
+			last.other = p.fset.Position(e.Pos)
+		}
+	}
+}
+
+// containerApps maps the containers of the generated app files to the functions of the features
+// they call.
+var containerApps = map[string]string{
+	"AppContainer":     "<Feature>App",
+	"GRPCContainer":    "<Feature>GRPCApp",
+	"ConnectContainer": "<Feature>ConnectApp",
+}
+
+// checkAppContainers reports every container of the module after the first one of its name, as
+// the generated app files of all features hang off a single container.
+func (d *doctor) checkAppContainers() {
+	sort.Slice(d.appContainers, func(i, j int) bool {
+		if d.appContainers[i].file != d.appContainers[j].file {
+			return d.appContainers[i].file < d.appContainers[j].file
+		}
+		return d.appContainers[i].line < d.appContainers[j].line
+	})
+	first := map[string]declaration{}
+	for _, decl := range d.appContainers {
+		prev, ok := first[decl.name]
+		if !ok {
+			first[decl.name] = decl
+			continue
+		}
+		d.report(decl.file, decl.line, "app-container",
+			fmt.Sprintf("%s is also defined at %s:%d", decl.name, prev.file, prev.line),
+			fmt.Sprintf("keep a single %s in the module and call each %s from it", decl.name, containerApps[decl.name]))
+	}
+}
+
+// checkRedeclarations reports the names declared twice in a package, which does not compile. The
+// containers are left to checkAppContainers.
+func (d *doctor) checkRedeclarations() {
+	for _, r := range d.redeclared {
+		if _, ok := containerApps[r.name]; ok {
+			continue
+		}
+		message := fmt.Sprintf("%s is declared twice in its package", r.name)
+		if r.other.IsValid() {
+			message = fmt.Sprintf("%s is also declared at %s:%d", r.name, r.other.Filename, r.other.Line)
+		}
+		d.report(r.pos.Filename, r.pos.Line, "redeclaration", message, "rename or remove one of the declarations")
+	}
+}
+
+// checkPortImplementations reports the interfaces of the ports layer that no type of the project
+// implements with the same method signatures.
+func (d *doctor) checkPortImplementations() {
+	for rel, pkg := range d.packages {
+		if pkg == nil || classifyPackage(d.layout, rel) != "ports" {
+			continue
+		}
+		scope := pkg.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			iface, ok := obj.Type().Underlying().(*types.Interface)
+			if !ok || iface.NumMethods() == 0 || d.implemented(iface) {
+				continue
+			}
+			d.reportPort(name, d.fset.Position(obj.Pos()))
+		}
+	}
+}
+
+func (d *doctor) reportPort(name string, pos token.Position) {
+	fix := "implement it in an adapter or service"
+	switch {
+	case strings.HasPrefix(name, "I") && strings.HasSuffix(name, "Repository"):
+		fix = fmt.Sprintf("gohexa -generate repository -feature %s", strings.TrimSuffix(strings.TrimPrefix(name, "I"), "Repository"))
+	case strings.HasPrefix(name, "I") && strings.HasSuffix(name, "Service"):
+		fix = fmt.Sprintf("gohexa -generate service -feature %s", strings.TrimSuffix(strings.TrimPrefix(name, "I"), "Service"))
+	}
+	d.report(pos.Filename, pos.Line, "port-implementation",
+		fmt.Sprintf("no type implements port %s", name), fix)
+}
+
+// implemented reports whether a type declared by a package of the project, or a pointer to it,
+// implements iface.
+func (d *doctor) implemented(iface *types.Interface) bool {
+	for _, pkg := range d.packages {
+		if pkg == nil {
+			continue
+		}
+		scope := pkg.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || obj.IsAlias() || types.IsInterface(obj.Type()) {
+				continue
+			}
+			if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+				continue
+			}
+			if types.Implements(obj.Type(), iface) || types.Implements(types.NewPointer(obj.Type()), iface) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *doctor) checkRouteRegistrations() {
+	called := map[string]bool{}
+	for _, call := range d.routeCalls {
+		called[call.name] = true
+		if _, ok := d.routeDefs[call.name]; ok {
+			continue
+		}
+		fix := "generate the routes with gohexa -generate route"
+		for _, candidate := range []string{call.name + "s", strings.TrimSuffix(call.name, "s")} {
+			if _, ok := d.routeDefs[candidate]; ok && candidate != call.name {
+				fix = fmt.Sprintf("call %s instead", candidate)
+				called[candidate] = true
+			}
+		}
+		d.report(call.file, call.line, "route-registration",
+			fmt.Sprintf("%s is called but not defined", call.name), fix)
+	}
+	for name, def := range d.routeDefs {
+		if called[name] {
+			continue
+		}
+		feature := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(name, "Create"), "s"), "Route")
+		d.report(def.file, def.line, "route-registration",
+			fmt.Sprintf("%s is never registered", name),
+			fmt.Sprintf("call r.%s(...) from %sApp", name, feature))
+	}
+}
+
+func (d *doctor) checkIDTypes() {
+	for feature, decls := range d.idTypes {
+		reference := decls[0]
+		for _, decl := range decls {
+			if strings.HasPrefix(decl.name, "model ") {
+				reference = decl
+				break
+			}
+		}
+		want := idKind(reference.name)
+		for _, decl := range decls {
+			if idKind(decl.name) == want {
+				continue
+			}
+			d.report(decl.file, decl.line, "id-type",
+				fmt.Sprintf("%s ID of %s is %s but the %s ID is %s", strings.Fields(decl.name)[0], feature,
+					strings.Fields(decl.name)[1], strings.Fields(reference.name)[0], strings.Fields(reference.name)[1]),
+				"regenerate the feature's layers with the same -uuid setting")
+		}
+	}
+}
+
+// idKind groups ID types that are interchangeable in the generated code.
+func idKind(entry string) string {
+	fields := strings.Fields(entry)
+	switch t := fields[len(fields)-1]; t {
+	case "uint", "uint32", "uint64", "int", "int64":
+		return "integer"
+	default:
+		return t
+	}
+}
+
+func isRouteRegistration(name string) bool {
+	return strings.HasPrefix(name, "Create") && (strings.HasSuffix(name, "Routes") || strings.HasSuffix(name, "Route"))
+}
+
+// flattenParams returns one type expression per parameter, expanding grouped names like (a, b int).
+func flattenParams(list *ast.FieldList) []ast.Expr {
+	var params []ast.Expr
+	if list == nil {
+		return params
+	}
+	for _, field := range list.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			params = append(params, field.Type)
+		}
+	}
+	return params
+}
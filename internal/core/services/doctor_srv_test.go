@@ -0,0 +1,81 @@
+package services
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// TestDiagnose diagnoses testdata/doctor, which has problems for each check. Its services and
+// handlers implement their ports, the handler one with types of an unresolved package, while the
+// repository takes a uint ID where the port takes a string.
+func TestDiagnose(t *testing.T) {
+	root := filepath.Join("testdata", "doctor")
+	diagnostics, err := NewInspectorService().Diagnose(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := func(rel string) string { return filepath.Join(root, filepath.FromSlash(rel)) }
+	tests := []struct {
+		check string
+		want  []domain.Diagnostic
+	}{
+		{"module-path", []domain.Diagnostic{{
+			File: file("internal/adapters/app/app.go"), Line: 5,
+			Message: `import "github.com/my_project/internal/core/domain/order" does not match module "example.com/shop" of go.mod`,
+			Fix:     `replace it with "example.com/shop/internal/core/domain/order", or regenerate with -project matching the module path`,
+		}}},
+		{"app-container", []domain.Diagnostic{{
+			File: file("internal/adapters/app/app.go"), Line: 10,
+			Message: "AppContainer is also defined at " + file("cmd/worker/main.go") + ":3",
+			Fix:     "keep a single AppContainer in the module and call each <Feature>App from it",
+		}, {
+			File: file("internal/adapters/app/order_grpc_app.go"), Line: 3,
+			Message: "GRPCContainer is also defined at " + file("internal/adapters/app/customer_grpc_app.go") + ":3",
+			Fix:     "keep a single GRPCContainer in the module and call each <Feature>GRPCApp from it",
+		}}},
+		{"redeclaration", []domain.Diagnostic{{
+			File: file("internal/adapters/app/order_grpc_app.go"), Line: 5,
+			Message: "setup is also declared at " + file("internal/adapters/app/customer_grpc_app.go") + ":5",
+			Fix:     "rename or remove one of the declarations",
+		}}},
+		{"port-implementation", []domain.Diagnostic{{
+			File: file("internal/core/ports/order/order_ports.go"), Line: 11,
+			Message: "no type implements port IOrderRepository",
+			Fix:     "gohexa -generate repository -feature Order",
+		}}},
+		{"route-registration", []domain.Diagnostic{{
+			File: file("internal/adapters/app/app.go"), Line: 12,
+			Message: "CreateOrderRoute is called but not defined",
+			Fix:     "call CreateOrderRoutes instead",
+		}, {
+			File: file("internal/adapters/http/routers/routes.go"), Line: 7,
+			Message: "CreateInvoiceRoutes is never registered",
+			Fix:     "call r.CreateInvoiceRoutes(...) from InvoiceApp",
+		}}},
+		{"id-type", []domain.Diagnostic{{
+			File: file("internal/core/ports/order/order_ports.go"), Line: 12,
+			Message: "port ID of Order is string but the model ID is uint",
+			Fix:     "regenerate the feature's layers with the same -uuid setting",
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.check, func(t *testing.T) {
+			var got []domain.Diagnostic
+			for _, d := range diagnostics {
+				if d.Check == tt.check {
+					d.Check = ""
+					got = append(got, d)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diagnostics:\n%+v\nwant:\n%+v", got, tt.want)
+			}
+		})
+	}
+	if len(diagnostics) != 8 {
+		t.Errorf("got %d diagnostics, want 8: %+v", len(diagnostics), diagnostics)
+	}
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +10,22 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// domainTemplate returns the template key, source and data of the domain file.
+func (g *GeneratorServiceImpls) domainTemplate(useUUID bool) (string, string, any) {
+	data := domain.DomainFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		UseUUID:     useUUID || g.flag.DB == "mongo",        // string IDs, see idType
+		DefaultUUID: "00000000-0000-0000-0000-000000000000", // Default UUID value
+		Fields:      g.fields(),
+		Dialect:     g.dialect(),
+	}
+	if g.flag.PureDomain {
+		return "domain.pure", domain.PureDomainTemplate, data
+	}
+	return "domain", domain.DomainTemplate, data
+}
+
 // GenerateDomainFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateDomainFile(dir string, useUUID bool) {
 	// Define the template for the domain file
@@ -19,18 +34,10 @@ func (g *GeneratorServiceImpls) GenerateDomainFile(dir string, useUUID bool) {
 	if err != nil {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
-	// Prepare the data for template rendering
-	data := domain.DomainFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-		UseUUID:     useUUID,
-		DefaultUUID: "00000000-0000-0000-0000-000000000000", // Default UUID value
-	}
 
-	// Parse and execute the template
-	tmpl, err := template.New("domain").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.DomainTemplate)
+	// Render the template
+	templateKey, templateText, data := g.domainTemplate(useUUID)
+	content, err := renderTemplate("domain", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -40,19 +47,12 @@ func (g *GeneratorServiceImpls) GenerateDomainFile(dir string, useUUID bool) {
 	fileName := fmt.Sprintf("%s_domain.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Domain file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Domain file '%s' created successfully!\n", filePath)
+	g.recordLayer("domain", templateKey, filePath)
+	g.generateValidationFile(dir)
 }
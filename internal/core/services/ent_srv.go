@@ -0,0 +1,95 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// entData returns the data of the ent templates.
+func (g *GeneratorServiceImpls) entData() domain.EntFlagDomain {
+	var fields []domain.EntField
+	for _, f := range g.fields() {
+		fields = append(fields, domain.EntField{
+			Name:     f.Name,
+			Column:   f.Column,
+			Type:     f.Type,
+			EntName:  utils.ToEntName(f.Column),
+			Builder:  domain.EntFieldBuilders[f.Type],
+			Nullable: f.Nullable,
+		})
+	}
+	lower := strings.ToLower(g.flag.FeatureName)
+	return domain.EntFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		UseUUID:     g.flag.UseUUID,
+		PureDomain:  g.flag.PureDomain,
+		Package:     lower,
+		Table:       g.table(),
+		Fields:      fields,
+	}
+}
+
+// entSchemaTemplate returns the template key, source and data of the ent schema. The key is empty
+// unless -orm ent is selected.
+func (g *GeneratorServiceImpls) entSchemaTemplate() (string, string, any) {
+	key, text := g.ormTemplate("ent_schema")
+	return key, text, g.entData()
+}
+
+// entClientTemplate, entPredicateTemplate and entEntityTemplate return what ent generates from the
+// schema, declarations only. They are only used to verify the repository.
+func (g *GeneratorServiceImpls) entClientTemplate() (string, string, any) {
+	return "ent.gen", domain.EntClientStub, g.entData()
+}
+
+func (g *GeneratorServiceImpls) entPredicateTemplate() (string, string, any) {
+	return "ent.gen.predicate", domain.EntPredicateStub, g.entData()
+}
+
+func (g *GeneratorServiceImpls) entEntityTemplate() (string, string, any) {
+	return "ent.gen.entity", domain.EntEntityStub, g.entData()
+}
+
+// generateEntFiles writes the ent schema of the feature, and ent/generate.go when it is missing.
+// Paths are relative to the project root.
+func (g *GeneratorServiceImpls) generateEntFiles() {
+	dir := filepath.Join("ent", "schema")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		fmt.Printf("Error creating directories: %v\n", err)
+		return
+	}
+	generatePath := filepath.Join("ent", "generate.go")
+	if _, err := os.Stat(generatePath); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(generatePath, []byte(domain.EntGenerateTemplate), 0644); err != nil {
+			fmt.Printf("Error writing to file: %v\n", err)
+			return
+		}
+		fmt.Printf("ent file '%s' created successfully!\n", generatePath)
+	}
+
+	// Render the template
+	templateKey, templateText, data := g.entSchemaTemplate()
+	content, err := renderTemplate(templateKey, templateText, data)
+	if err != nil {
+		fmt.Printf("Error parsing template: %v\n", err)
+		return
+	}
+
+	// Write the output file
+	filePath := filepath.Join(dir, strings.ToLower(g.flag.FeatureName)+".go")
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		fmt.Printf("Error writing to file: %v\n", err)
+		return
+	}
+	fmt.Printf("ent file '%s' created successfully!\n", filePath)
+	g.recordLayer("ent_schema", templateKey, filePath)
+	fmt.Println("Generate the ent client of the repository with: go generate ./ent")
+}
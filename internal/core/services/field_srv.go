@@ -0,0 +1,136 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// ParseFields parses a -fields value such as "name:string,total:float64,paid_at:time". A type
+// may be followed by options of the column: index, unique, ref=<table>, was=<previous name>, null or
+// default=<SQL expression>, e.g. "customer_id:uint:index:ref=customers,status:string:default='new'",
+// by json=<name>, and by the constraints of Validate: required, min=<n>, max=<n>, enum=<a|b>,
+// format=<format> or pattern=<regexp>. An empty spec yields domain.DefaultFields.
+func ParseFields(spec string) ([]domain.Field, error) {
+	if strings.TrimSpace(spec) == "" {
+		return domain.DefaultFields, nil
+	}
+	var fields []domain.Field
+	seen := map[string]bool{}
+	for _, item := range strings.Split(spec, ",") {
+		name, typ, ok := strings.Cut(strings.TrimSpace(item), ":")
+		if !ok || name == "" || typ == "" {
+			return nil, fmt.Errorf("invalid field %q, expected name:type", item)
+		}
+		typ, options, _ := strings.Cut(typ, ":")
+		goType, ok := domain.FieldTypes[typ]
+		if !ok {
+			return nil, fmt.Errorf("unsupported type %q of field %q", typ, name)
+		}
+		field := domain.Field{Name: utils.ToCamel(name), Type: goType, Column: utils.ToSnake(name)}
+		if err := parseFieldOptions(&field, options); err != nil {
+			return nil, fmt.Errorf("field %q: %v", name, err)
+		}
+		switch field.Name {
+		case "ID", "CreatedAt", "UpdatedAt", "DeletedAt":
+			return nil, fmt.Errorf("field %q is always generated", name)
+		}
+		if seen[field.Name] {
+			return nil, fmt.Errorf("duplicate field %q", name)
+		}
+		seen[field.Name] = true
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// parseFieldOptions sets the column options and the constraints of field from the options after
+// its type.
+func parseFieldOptions(field *domain.Field, options string) error {
+	if options == "" {
+		return nil
+	}
+	for _, option := range strings.Split(options, ":") {
+		key, value, hasValue := strings.Cut(option, "=")
+		if hasValue && value == "" {
+			return fmt.Errorf("%s needs a value, e.g. %s", key, fieldOptionExamples[key])
+		}
+		text := field.Type == "string"
+		number := field.Type != "string" && field.Type != "bool" && field.Type != "time.Time"
+		switch {
+		case key == "ref" && hasValue:
+			field.References = value
+		case key == "was" && hasValue:
+			field.Renamed = utils.ToSnake(value)
+		case key == "default" && hasValue:
+			field.Default = value
+		case key == "json" && hasValue:
+			field.JSON = value
+		case (key == "min" || key == "max") && hasValue:
+			if !text && !number {
+				return fmt.Errorf("%s applies to strings and numbers", key)
+			}
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("%s needs a number, e.g. %s", key, fieldOptionExamples[key])
+			}
+			if text || strings.Contains(field.Type, "int") {
+				n, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return fmt.Errorf("%s of a %s needs an integer", key, field.Type)
+				}
+				if n < 0 && (text || strings.HasPrefix(field.Type, "uint")) {
+					return fmt.Errorf("%s of a %s cannot be negative", key, field.Type)
+				}
+			}
+			if key == "min" {
+				field.Min = value
+			} else {
+				field.Max = value
+			}
+		case key == "enum" && hasValue && text:
+			field.Enum = value
+		case key == "format" && hasValue && text:
+			if !slices.Contains(domain.FieldFormats, value) {
+				return fmt.Errorf("unknown format %q, expected %s", value, strings.Join(domain.FieldFormats, ", "))
+			}
+			field.Format = value
+		case key == "pattern" && hasValue && text:
+			if _, err := regexp.Compile(value); err != nil {
+				return fmt.Errorf("invalid pattern: %v", err)
+			}
+			field.Pattern = value
+		case (key == "enum" || key == "format" || key == "pattern") && hasValue:
+			return fmt.Errorf("%s applies to strings", key)
+		case option == "required":
+			if !text && field.Type != "time.Time" {
+				return fmt.Errorf("required applies to strings and times, whose zero value is missing")
+			}
+			field.Required = true
+		case option == "null":
+			field.Nullable = true
+		case slices.Contains(domain.FieldIndexes, option):
+			field.Index = option
+		default:
+			return fmt.Errorf("unknown option %q, expected index, unique, ref=<table>, was=<column>, null, default=<expression>, json=<name>, required, min=<n>, max=<n>, enum=<a|b>, format=<format> or pattern=<regexp>", option)
+		}
+	}
+	return nil
+}
+
+// fieldOptionExamples are the examples of the errors of options without a value.
+var fieldOptionExamples = map[string]string{
+	"ref":     "ref=customers",
+	"was":     "was=total",
+	"default": "default=0",
+	"json":    "json=customerId",
+	"min":     "min=1",
+	"max":     "max=120",
+	"enum":    "enum=new|paid",
+	"format":  "format=email",
+	"pattern": "pattern=^[A-Z]+$",
+}
@@ -1,6 +1,12 @@
 package services
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
 	"github.com/rapidstellar/gohexa/internal/core/domain"
 	"github.com/rapidstellar/gohexa/internal/core/ports"
 )
@@ -12,3 +18,128 @@ type GeneratorServiceImpls struct {
 func NewGeneratorService(flag domain.GeneratorFlagDomain) ports.IGeneratorService {
 	return &GeneratorServiceImpls{flag}
 }
+
+// fields returns the feature's fields, falling back to the placeholder fields.
+func (g *GeneratorServiceImpls) fields() []domain.Field {
+	if len(g.flag.Fields) == 0 {
+		return domain.DefaultFields
+	}
+	return g.flag.Fields
+}
+
+// nullable reports whether a field of the feature is nullable, for which the adapters converting
+// its pointer need the "nullable" helpers.
+func (g *GeneratorServiceImpls) nullable() bool {
+	return slices.ContainsFunc(g.fields(), func(f domain.Field) bool { return f.Nullable })
+}
+
+// table returns the table of the feature: the lower-case plural of its name unless set.
+func (g *GeneratorServiceImpls) table() string {
+	if g.flag.Table != "" {
+		return g.flag.Table
+	}
+	return strings.ToLower(g.flag.FeatureName) + "s"
+}
+
+// idType returns the Go type of the feature's ID field.
+// With -db mongo it is always a string: a UUID, or the hex of the ObjectID.
+func (g *GeneratorServiceImpls) idType() string {
+	if g.flag.UseUUID || g.flag.DB == "mongo" {
+		return "string"
+	}
+	return "uint"
+}
+
+// httpTemplate returns the key and source of a template of the HTTP adapter, for the framework
+// selected with -http. An empty key means the framework has no such template.
+func (g *GeneratorServiceImpls) httpTemplate(key string) (string, string) {
+	return variantTemplate(key, g.flag.HTTP, domain.HTTPFrameworks)
+}
+
+// rpcTemplate returns the key and source of a template of the RPC adapter, for the framework
+// selected with -rpc.
+func (g *GeneratorServiceImpls) rpcTemplate(key string) (string, string) {
+	return variantTemplate(key, g.flag.RPC, domain.RPCFrameworks)
+}
+
+// persistence returns the variant of the persistence templates: the library selected with -orm, or
+// mongo for -db mongo.
+func (g *GeneratorServiceImpls) persistence() string {
+	if g.flag.DB == "mongo" {
+		return "mongo"
+	}
+	if g.flag.ORM == "" {
+		return domain.ORMs[0]
+	}
+	return g.flag.ORM
+}
+
+// dialect returns the SQL dialect of the database selected with -db, postgres by default.
+func (g *GeneratorServiceImpls) dialect() domain.Dialect {
+	if d, ok := domain.Dialects[g.flag.DB]; ok {
+		return d
+	}
+	return domain.Dialects["postgres"]
+}
+
+// ormTemplate returns the key and source of a template of the persistence adapter, for the library
+// selected with -orm, or for -db mongo.
+func (g *GeneratorServiceImpls) ormTemplate(key string) (string, string) {
+	return variantTemplate(key, g.persistence(), domain.ORMs)
+}
+
+// dbHandle returns the database handle of the persistence templates, with its import path rendered
+// for the project.
+func (g *GeneratorServiceImpls) dbHandle() domain.DBHandle {
+	handle := domain.DBHandles[g.persistence()]
+	if importPath, err := renderTemplate("db import", handle.Import, g.flag); err == nil {
+		handle.Import = string(importPath)
+	}
+	return handle
+}
+
+// variantTemplate returns the "<key>.<variant>" template, or the key itself for the default
+// variant, which is the first of variants. An empty key means there is no such template.
+func variantTemplate(key, variant string, variants []string) (string, string) {
+	if variant != "" && variant != variants[0] {
+		key += "." + variant
+	}
+	text, ok := domain.Templates[key]
+	if !ok {
+		return "", ""
+	}
+	return key, text
+}
+
+// GenerateFeatureLayers implements ports.IGeneratorService. It writes each of layers, which are keys
+// of domain.FeatureLayerDirs, to its directory below root.
+func (g *GeneratorServiceImpls) GenerateFeatureLayers(root string, layers []string) {
+	lower := strings.ToLower(g.flag.FeatureName)
+	for _, layer := range layers {
+		dir := filepath.Join(root, strings.ReplaceAll(domain.FeatureLayerDirs[layer], "%s", lower))
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			fmt.Printf("Error creating directories: %v\n", err)
+			return
+		}
+		switch layer {
+		case "model":
+			g.GenerateModelsFile(dir, g.flag.UseUUID)
+		case "domain":
+			g.GenerateDomainFile(dir, g.flag.UseUUID)
+		case "port":
+			g.GeneratePortsFile(dir)
+		case "repository":
+			g.GenerateRepoFile(dir)
+		case "service":
+			g.GenerateServiceFile(dir)
+		case "handler":
+			g.GenerateHandlerFile(dir)
+		case "route":
+			g.GenerateRouteFile(dir)
+		case "app":
+			g.GenerateAppFile(dir)
+		case "migration":
+			g.GenerateMigrationFiles(dir)
+		}
+	}
+}
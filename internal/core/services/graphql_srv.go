@@ -0,0 +1,128 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// graphqlData returns the data of the GraphQL templates.
+func (g *GeneratorServiceImpls) graphqlData() domain.GraphQLFlagDomain {
+	var fields []domain.GraphQLField
+	for _, f := range g.fields() {
+		gqlType := domain.GraphQLTypes[f.Type]
+		goType := domain.GraphQLGoTypes[gqlType]
+		field := domain.GraphQLField{
+			Name:        f.Name,
+			GraphQLName: utils.ToLowerCamel(f.Column),
+			GraphQLType: gqlType,
+			Nullable:    f.Nullable,
+			ToModel:     "d." + f.Name,
+			FromInput:   "in." + f.Name,
+		}
+		switch {
+		case f.Type == goType:
+		case f.Nullable:
+			// gqlgen generates a pointer for the nullable field, converted keeping nil.
+			prefix := utils.ToLowerCamel(g.flag.FeatureName)
+			field.ToModel = prefix + "Pointer(" + goType + "(" + prefix + "Value(d." + f.Name + ")), d." + f.Name + " != nil)"
+			field.FromInput = prefix + "Pointer(" + f.Type + "(" + prefix + "Value(in." + f.Name + ")), in." + f.Name + " != nil)"
+		default:
+			field.ToModel = goType + "(d." + f.Name + ")"
+			field.FromInput = f.Type + "(in." + f.Name + ")"
+		}
+		fields = append(fields, field)
+	}
+	return domain.GraphQLFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		Fields:      fields,
+		Nullable:    g.nullable(),
+	}
+}
+
+// graphqlSchemaTemplate returns the template key, source and data of the schema fragment.
+func (g *GeneratorServiceImpls) graphqlSchemaTemplate() (string, string, any) {
+	return "graphql", domain.GraphQLSchemaTemplate, g.graphqlData()
+}
+
+// graphqlResolverTemplate returns the template key, source and data of the resolvers.
+func (g *GeneratorServiceImpls) graphqlResolverTemplate() (string, string, any) {
+	return "resolver", domain.GraphQLResolverTemplate, g.graphqlData()
+}
+
+// graphqlConvertTemplate returns the template key, source and data of the resolver helpers.
+func (g *GeneratorServiceImpls) graphqlConvertTemplate() (string, string, any) {
+	return "resolver_convert", domain.GraphQLConvertTemplate, g.graphqlData()
+}
+
+// graphqlModelTemplate and graphqlResolverBaseTemplate return what gqlgen generates, declarations
+// only. They are only used to verify the resolvers.
+func (g *GeneratorServiceImpls) graphqlModelTemplate() (string, string, any) {
+	return "graphql.model", domain.GraphQLModelStub, g.graphqlData()
+}
+
+func (g *GeneratorServiceImpls) graphqlResolverBaseTemplate() (string, string, any) {
+	return "graphql.resolver", domain.GraphQLResolverStub, g.graphqlData()
+}
+
+// GenerateGraphQLFiles implements ports.IGeneratorService.
+// It writes the schema fragment, resolvers and their helpers below the project root dir, and the
+// gqlgen configuration and base schema when the project has none.
+func (g *GeneratorServiceImpls) GenerateGraphQLFiles(dir string) {
+	lower := strings.ToLower(g.flag.FeatureName)
+	graphqlDir := filepath.Join(dir, "internal", "adapters", "graphql")
+	schemaDir := filepath.Join(graphqlDir, "schema")
+	if err := os.MkdirAll(schemaDir, os.ModePerm); err != nil {
+		fmt.Printf("Error creating directories: %v\n", err)
+		return
+	}
+
+	for _, base := range []struct{ path, text string }{
+		{filepath.Join(dir, "gqlgen.yml"), domain.GqlgenConfigTemplate},
+		{filepath.Join(schemaDir, "schema.graphqls"), domain.GraphQLBaseSchemaTemplate},
+	} {
+		if _, err := os.Stat(base.path); !errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err := os.WriteFile(base.path, []byte(base.text), 0644); err != nil {
+			fmt.Printf("Error writing to file: %v\n", err)
+			return
+		}
+		fmt.Printf("GraphQL file '%s' created successfully!\n", base.path)
+	}
+
+	files := []struct {
+		layer, path string
+		render      func() (string, string, any)
+	}{
+		{"graphql", filepath.Join(schemaDir, lower+".graphqls"), g.graphqlSchemaTemplate},
+		{"resolver", filepath.Join(graphqlDir, lower+".resolvers.go"), g.graphqlResolverTemplate},
+		{"resolver_convert", filepath.Join(graphqlDir, lower+"_convert.go"), g.graphqlConvertTemplate},
+	}
+	for _, f := range files {
+		// Render the template
+		templateKey, templateText, data := f.render()
+		content, err := renderTemplate(templateKey, templateText, data)
+		if err != nil {
+			fmt.Printf("Error parsing template: %v\n", err)
+			return
+		}
+
+		// Write the output file
+		if err := os.WriteFile(f.path, content, 0644); err != nil {
+			fmt.Printf("Error writing to file: %v\n", err)
+			return
+		}
+		fmt.Printf("GraphQL file '%s' created successfully!\n", f.path)
+		g.recordLayer(f.layer, templateKey, f.path)
+	}
+	fmt.Printf("Add the service to the Resolver in internal/adapters/graphql/resolver.go:\n  %sService ports.I%sService\nthen run: go run github.com/99designs/gqlgen generate\n",
+		g.flag.FeatureName, g.flag.FeatureName)
+}
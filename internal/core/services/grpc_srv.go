@@ -0,0 +1,220 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// protoFields returns the fields of the feature's message: the ID, the timestamps and the
+// feature's fields, numbered in this order.
+func (g *GeneratorServiceImpls) protoFields() []domain.ProtoField {
+	fields := append([]domain.Field{
+		{Name: "ID", Type: g.idType(), Column: "id"},
+		{Name: "CreatedAt", Type: "time.Time", Column: "created_at"},
+		{Name: "UpdatedAt", Type: "time.Time", Column: "updated_at"},
+	}, g.fields()...)
+
+	protoFields := make([]domain.ProtoField, 0, len(fields))
+	for i, f := range fields {
+		protoType := domain.ProtoTypes[f.Type]
+		pf := domain.ProtoField{
+			Name:      f.Name,
+			Column:    f.Column,
+			GoName:    utils.ToProtoGoName(f.Column),
+			ProtoType: protoType,
+			GoType:    domain.ProtoGoTypes[protoType],
+			Number:    i + 1,
+		}
+		switch {
+		case f.Nullable:
+			nullableProtoField(&pf, f, utils.ToLowerCamel(g.flag.FeatureName))
+		case f.Type == "time.Time":
+			pf.ToProto = "timestamppb.New(d." + pf.Name + ")"
+			pf.FromProto = "m." + pf.GoName + ".AsTime()"
+		case f.Type == pf.GoType:
+			pf.ToProto = "d." + pf.Name
+			pf.FromProto = "m." + pf.GoName
+		default:
+			pf.ToProto = pf.GoType + "(d." + pf.Name + ")"
+			pf.FromProto = f.Type + "(m." + pf.GoName + ")"
+		}
+		protoFields = append(protoFields, pf)
+	}
+	return protoFields
+}
+
+// nullableProtoField sets the conversions of a nullable field, whose pointer is nil for NULL, with
+// the "nullable" helpers prefixed with prefix. Scalars are optional in the message, so that nil
+// survives; timestamps are messages, which are nil already.
+func nullableProtoField(pf *domain.ProtoField, f domain.Field, prefix string) {
+	d, m := "d."+pf.Name, "m."+pf.GoName
+	if f.Type == "time.Time" {
+		pf.ToProto = prefix + "Map(" + d + ", timestamppb.New)"
+		pf.FromProto = prefix + "Pointer(" + m + ".AsTime(), " + m + " != nil)"
+		return
+	}
+	pf.Optional = true
+	if f.Type == pf.GoType {
+		pf.ToProto, pf.FromProto = d, m
+	} else {
+		pf.ToProto = prefix + "Pointer(" + pf.GoType + "(" + prefix + "Value(" + d + ")), " + d + " != nil)"
+		pf.FromProto = prefix + "Pointer(" + f.Type + "(" + prefix + "Value(" + m + ")), " + m + " != nil)"
+	}
+	pf.GoType = "*" + pf.GoType
+}
+
+// grpcData returns the data of the gRPC templates.
+func (g *GeneratorServiceImpls) grpcData() domain.GRPCFlagDomain {
+	return domain.GRPCFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		IDProtoType: domain.ProtoTypes[g.idType()],
+		Fields:      g.protoFields(),
+		Nullable:    g.nullable(),
+		DB:          g.dbHandle(),
+	}
+}
+
+// protoTemplate returns the template key, source and data of the .proto file.
+func (g *GeneratorServiceImpls) protoTemplate() (string, string, any) {
+	return "proto", domain.ProtoTemplate, g.grpcData()
+}
+
+// grpcServerTemplate returns the template key, source and data of the server adapter of the
+// framework selected with -rpc.
+func (g *GeneratorServiceImpls) grpcServerTemplate() (string, string, any) {
+	key, text := g.rpcTemplate("grpc")
+	return key, text, g.grpcData()
+}
+
+// grpcAppTemplate returns the template key, source and data of the server registration in the app.
+func (g *GeneratorServiceImpls) grpcAppTemplate() (string, string, any) {
+	key, text := g.rpcTemplate("grpc_app")
+	return key, text, g.grpcData()
+}
+
+// grpcContainerTemplate returns the template key, source and data of the container that registers
+// the servers of every feature in the app.
+func (g *GeneratorServiceImpls) grpcContainerTemplate() (string, string, any) {
+	key, text := g.rpcTemplate("grpc_container")
+	return key, text, g.grpcData()
+}
+
+// rpcContainers describes the container of each RPC framework: its file in internal/adapters/app,
+// the call each feature adds to it, with %s the feature, and the return the call goes before.
+var rpcContainers = map[string]struct{ file, call, ret string }{
+	"grpc":    {"grpc_container.go", "\t%sGRPCApp(s, db)\n", "\treturn s\n"},
+	"connect": {"connect_container.go", "\t%sConnectApp(mux, db)\n", "\treturn mux\n"},
+}
+
+// writeRPCContainer writes the container of the selected RPC framework to dir if it does not exist
+// yet, and otherwise adds the call of the feature to it, so every feature shares one container.
+func (g *GeneratorServiceImpls) writeRPCContainer(dir string) error {
+	rpc := g.flag.RPC
+	if rpc == "" {
+		rpc = domain.RPCFrameworks[0]
+	}
+	container := rpcContainers[rpc]
+	filePath := filepath.Join(dir, container.file)
+	existing, err := os.ReadFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		templateKey, templateText, data := g.grpcContainerTemplate()
+		content, err := renderTemplate(templateKey, templateText, data)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("gRPC file '%s' created successfully!\n", filePath)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	call := fmt.Sprintf(container.call, g.flag.FeatureName)
+	if strings.Contains(string(existing), call) {
+		return nil
+	}
+	i := strings.LastIndex(string(existing), container.ret)
+	if i < 0 {
+		return fmt.Errorf("no %q in %s to add %s before", strings.TrimSpace(container.ret), filePath, strings.TrimSpace(call))
+	}
+	content := string(existing[:i]) + call + string(existing[i:])
+	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("gRPC file '%s' updated with %s.\n", filePath, strings.TrimSpace(call))
+	return nil
+}
+
+// grpcPbTemplate returns what protoc generates from the .proto file, declarations only.
+// It is only used to verify the adapter.
+func (g *GeneratorServiceImpls) grpcPbTemplate() (string, string, any) {
+	return "grpc.pb", domain.GRPCPbStub, g.grpcData()
+}
+
+// connectPbTemplate returns what protoc-gen-connect-go generates from the .proto file, declarations
+// only. The key is empty unless -rpc connect is selected.
+func (g *GeneratorServiceImpls) connectPbTemplate() (string, string, any) {
+	if g.flag.RPC != "connect" {
+		return "", "", nil
+	}
+	return "grpc.pbconnect", domain.ConnectPbStub, g.grpcData()
+}
+
+// GenerateGRPCFiles implements ports.IGeneratorService.
+// It writes the .proto contract, the server adapter and its registration below the project root dir,
+// and adds the feature to the container of the app.
+func (g *GeneratorServiceImpls) GenerateGRPCFiles(dir string) {
+	lower := strings.ToLower(g.flag.FeatureName)
+	files := []struct {
+		layer, dir, name string
+		render           func() (string, string, any)
+	}{
+		{"proto", filepath.Join(dir, "api", "proto", lower), lower + ".proto", g.protoTemplate},
+		{"grpc", filepath.Join(dir, "internal", "adapters", "grpc", "servers", lower), lower + "_server.go", g.grpcServerTemplate},
+		{"grpc_app", filepath.Join(dir, "internal", "adapters", "app"), lower + "_grpc_app.go", g.grpcAppTemplate},
+	}
+	for _, f := range files {
+		if err := os.MkdirAll(f.dir, os.ModePerm); err != nil {
+			fmt.Printf("Error creating directories: %v\n", err)
+			return
+		}
+
+		// Render the template
+		templateKey, templateText, data := f.render()
+		content, err := renderTemplate(templateKey, templateText, data)
+		if err != nil {
+			fmt.Printf("Error parsing template: %v\n", err)
+			return
+		}
+
+		// Write the output file
+		filePath := filepath.Join(f.dir, f.name)
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			fmt.Printf("Error writing to file: %v\n", err)
+			return
+		}
+		fmt.Printf("gRPC file '%s' created successfully!\n", filePath)
+		g.recordLayer(f.layer, templateKey, filePath)
+	}
+	if err := g.writeRPCContainer(filepath.Join(dir, "internal", "adapters", "app")); err != nil {
+		fmt.Printf("Error writing the container: %v\n", err)
+		return
+	}
+	plugin := "go-grpc"
+	if g.flag.RPC == "connect" {
+		plugin = "connect-go"
+	}
+	fmt.Printf("Generate the Go code of the contract with:\n  protoc --go_out=. --go_opt=module=github.com/%s --%s_out=. --%s_opt=module=github.com/%s api/proto/%s/%s.proto\n",
+		g.flag.ProjectName, plugin, plugin, g.flag.ProjectName, lower, lower)
+}
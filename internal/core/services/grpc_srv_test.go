@@ -0,0 +1,63 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// TestRPCContainer generates the gRPC files of Order, Customer and Order again for each RPC
+// framework: the features share one container, which calls each of them once.
+func TestRPCContainer(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	for _, tt := range []struct {
+		rpc, file, container string
+		calls                []string
+	}{
+		{"grpc", "grpc_container.go", "func GRPCContainer(", []string{"OrderGRPCApp(s, db)", "CustomerGRPCApp(s, db)"}},
+		{"connect", "connect_container.go", "func ConnectContainer(", []string{"OrderConnectApp(mux, db)", "CustomerConnectApp(mux, db)"}},
+	} {
+		t.Run(tt.rpc, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.Chdir(root); err != nil {
+				t.Fatal(err)
+			}
+			for _, feature := range []string{"Order", "Customer", "Order"} {
+				g := &GeneratorServiceImpls{flag: domain.GeneratorFlagDomain{FeatureName: feature, ProjectName: "my_project", RPC: tt.rpc}}
+				g.GenerateGRPCFiles(".")
+			}
+			dir := filepath.Join(root, "internal", "adapters", "app")
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			containers := 0
+			for _, entry := range entries {
+				content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				containers += strings.Count(string(content), tt.container)
+			}
+			if containers != 1 {
+				t.Errorf("%d %s declarations in %s, want 1", containers, tt.container, dir)
+			}
+			content, err := os.ReadFile(filepath.Join(dir, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, call := range tt.calls {
+				if n := strings.Count(string(content), call); n != 1 {
+					t.Errorf("%s calls %s %d times, want once:\n%s", tt.file, call, n, content)
+				}
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +10,19 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// handlerTemplate returns the template key, source and data of the handler file.
+func (g *GeneratorServiceImpls) handlerTemplate() (string, string, any) {
+	data := domain.HandlerFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		Validate:    g.validates(),
+		Operations:  g.flag.Operations,
+	}
+	key, text := g.httpTemplate("handler")
+	return key, text, data
+}
+
 // GenerateHandlerFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateHandlerFile(dir string) {
 	// Default to current directory if not provided
@@ -20,16 +32,9 @@ func (g *GeneratorServiceImpls) GenerateHandlerFile(dir string) {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
 
-	// Prepare the data for template rendering
-	data := domain.HandlerFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-	}
-
-	// Parse and execute the template
-	tmpl, err := template.New("handler").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.HandlerTemplate)
+	// Render the template
+	templateKey, templateText, data := g.handlerTemplate()
+	content, err := renderTemplate("handler", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -39,19 +44,11 @@ func (g *GeneratorServiceImpls) GenerateHandlerFile(dir string) {
 	fileName := fmt.Sprintf("%s_handlers.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Handlers file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Handlers file '%s' created successfully!\n", filePath)
+	g.recordLayer("handler", templateKey, filePath)
 }
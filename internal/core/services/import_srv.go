@@ -0,0 +1,190 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/internal/core/ports"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+type ImportServiceImpls struct{}
+
+func NewImportService() ports.IImportService {
+	return &ImportServiceImpls{}
+}
+
+// generatedColumns are written by every model, so they are not fields of imported features.
+var generatedColumns = map[string]bool{"id": true, "created_at": true, "updated_at": true, "deleted_at": true}
+
+// Features implements ports.IImportService. Each table with a single id primary key becomes a
+// feature named after its singular; the other tables and the columns of unsupported types are
+// skipped with a warning.
+func (s *ImportServiceImpls) Features(tables []domain.SchemaTable) ([]domain.FeatureSpec, []string) {
+	var specs []domain.FeatureSpec
+	var warnings []string
+	for _, table := range tables {
+		var keys []domain.SchemaColumn
+		for _, c := range table.Columns {
+			if c.PrimaryKey {
+				keys = append(keys, c)
+			}
+		}
+		if len(keys) != 1 || keys[0].Name != "id" {
+			warnings = append(warnings, fmt.Sprintf("skipping table %s: the generated repositories need a single id primary key", table.Name))
+			continue
+		}
+		idType, _ := importType(keys[0].Type)
+
+		var fields []string
+		for _, c := range table.Columns {
+			if generatedColumns[c.Name] {
+				continue
+			}
+			goType, ok := importType(c.Type)
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("skipping column %s.%s: unsupported type %s", table.Name, c.Name, c.Type))
+				continue
+			}
+			field := c.Name + ":" + goType
+			if c.Index != "" {
+				field += ":" + c.Index
+			}
+			if c.References != "" {
+				field += ":ref=" + c.References
+			}
+			if c.Nullable {
+				field += ":null"
+			}
+			if def, ok := importDefault(c.Default, goType); !ok {
+				warnings = append(warnings, fmt.Sprintf("skipping the default of %s.%s: %s is not portable", table.Name, c.Name, c.Default))
+			} else if def != "" {
+				field += ":default=" + def
+			}
+			fields = append(fields, field)
+		}
+		if len(fields) == 0 {
+			warnings = append(warnings, fmt.Sprintf("skipping table %s: it has no columns besides the generated ones", table.Name))
+			continue
+		}
+		specs = append(specs, domain.FeatureSpec{
+			Feature: utils.ToCamel(utils.Singularize(table.Name)),
+			Table:   table.Name,
+			UUID:    idType == "string",
+			Fields:  strings.Join(fields, ","),
+		})
+	}
+	return specs, warnings
+}
+
+// importDefault returns the default of a column of the Go type goType as an expression that every
+// dialect accepts, e.g. CURRENT_TIMESTAMP for now() and 'new' for 'new'::character varying. It reports
+// false for other expressions, such as function calls, and for literals -fields cannot hold.
+func importDefault(expr, goType string) (string, bool) {
+	expr = strings.TrimSpace(expr)
+	for strings.HasPrefix(expr, "(") && strings.HasSuffix(expr, ")") {
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	// A PostgreSQL cast, after a quoted literal that may hold ::.
+	if i := strings.LastIndex(expr, "::"); i > 0 && strings.Count(expr[:i], "'")%2 == 0 {
+		expr = strings.TrimSpace(expr[:i])
+	}
+	// MySQL dumps quote the defaults of numbers, e.g. '0'.
+	if goType != "string" && goType != "time.Time" && len(expr) > 1 && strings.HasPrefix(expr, "'") && strings.HasSuffix(expr, "'") {
+		expr = expr[1 : len(expr)-1]
+	}
+	upper := strings.ToUpper(expr)
+	switch {
+	case expr == "", upper == "NULL":
+		return "", true
+	case upper == "NOW()" || upper == "LOCALTIMESTAMP" || strings.HasPrefix(upper, "CURRENT_TIMESTAMP"):
+		return "CURRENT_TIMESTAMP", true
+	case goType == "bool" && (upper == "1" || upper == "B'1'"):
+		return "TRUE", true
+	case goType == "bool" && (upper == "0" || upper == "B'0'"):
+		return "FALSE", true
+	case upper == "TRUE" || upper == "FALSE":
+		return upper, true
+	case strings.ContainsAny(expr, ",:"):
+		return "", false
+	case len(expr) > 1 && strings.HasPrefix(expr, "'") && strings.HasSuffix(expr, "'"):
+		return expr, true
+	}
+	if _, err := strconv.ParseFloat(expr, 64); err == nil {
+		return expr, true
+	}
+	return "", false
+}
+
+// importType returns the Go type of a column of the SQL type sqlType, e.g. int64 for BIGINT and
+// uint32 for MySQL's int unsigned. MySQL's tinyint(1) is a bool. Arrays have no field type.
+func importType(sqlType string) (string, bool) {
+	t := strings.ToLower(strings.TrimSpace(sqlType))
+	if strings.HasSuffix(t, "]") || strings.HasSuffix(t, " array") {
+		return "", false
+	}
+	unsigned := strings.Contains(t, "unsigned")
+	t = strings.TrimSpace(strings.NewReplacer("unsigned", "", "zerofill", "").Replace(t))
+	if t == "tinyint(1)" || t == "bit(1)" {
+		return "bool", true
+	}
+	base, _, _ := strings.Cut(t, "(")
+	base = strings.TrimSpace(base)
+	if base == "tinyint" {
+		base = "smallint"
+	}
+
+	goType, ok := domain.ImportTypes[base]
+	if !ok {
+		// The affinity rules of SQLite, which accepts any type name.
+		switch {
+		case strings.Contains(base, "int"):
+			goType = "int64"
+		case strings.Contains(base, "char"), strings.Contains(base, "clob"), strings.Contains(base, "text"):
+			goType = "string"
+		case strings.Contains(base, "real"), strings.Contains(base, "floa"), strings.Contains(base, "doub"):
+			goType = "float64"
+		default:
+			return "", false
+		}
+	}
+	if unsigned && domain.UnsignedTypes[goType] != "" {
+		goType = domain.UnsignedTypes[goType]
+	}
+	return goType, true
+}
+
+// importedField is a field of an imported feature in the syntax of -fields. The options -fields
+// rejects are skipped with a warning.
+type importedField struct {
+	name     string
+	typ      string
+	where    string // e.g. property Pet.ownerEmail
+	field    domain.Field
+	options  []string
+	warnings *[]string
+}
+
+func newImportedField(name, typ, where string, warnings *[]string) *importedField {
+	return &importedField{name: name, typ: typ, where: where, field: domain.Field{Type: domain.FieldTypes[typ]}, warnings: warnings}
+}
+
+// add appends an option, unless -fields rejects it.
+func (f *importedField) add(option string) {
+	if strings.ContainsAny(option, ",:") {
+		*f.warnings = append(*f.warnings, fmt.Sprintf("skipping %s of %s: -fields cannot have a comma or a colon", option, f.where))
+		return
+	}
+	if err := parseFieldOptions(&f.field, option); err != nil {
+		*f.warnings = append(*f.warnings, fmt.Sprintf("skipping %s of %s: %v", option, f.where, err))
+		return
+	}
+	f.options = append(f.options, option)
+}
+
+// String returns the field in the syntax of -fields, e.g. owner_email:string:format=email.
+func (f *importedField) String() string {
+	return strings.Join(append([]string{f.name, f.typ}, f.options...), ":")
+}
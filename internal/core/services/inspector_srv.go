@@ -0,0 +1,178 @@
+package services
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/internal/core/ports"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+type InspectorServiceImpls struct{}
+
+func NewInspectorService() ports.IInspectorService {
+	return &InspectorServiceImpls{}
+}
+
+// sourceFile is a parsed Go file of the inspected project.
+type sourceFile struct {
+	path string
+	file *ast.File
+}
+
+// parseProject parses every Go file below root, skipping vendored, hidden and testdata directories.
+// Files that fail to parse are left out of files, and their errors are returned in broken.
+func parseProject(root string) (fset *token.FileSet, files []sourceFile, broken scanner.ErrorList, err error) {
+	fset = token.NewFileSet()
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (name == "vendor" || name == "testdata" || name == "node_modules" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			// Half-edited files should not stop the scan.
+			if list, ok := err.(scanner.ErrorList); ok {
+				broken = append(broken, list...)
+			} else {
+				broken.Add(token.Position{Filename: path}, err.Error())
+			}
+			return nil
+		}
+		files = append(files, sourceFile{path: path, file: file})
+		return nil
+	})
+	return fset, files, broken, err
+}
+
+// detectLayer recognises the declarations gohexa generates and returns the layer and feature they belong to.
+func detectLayer(decl ast.Decl) (layer, feature string) {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		name := d.Name.Name
+		if d.Recv != nil {
+			if strings.HasPrefix(name, "Create") && strings.HasSuffix(name, "Routes") {
+				return "route", strings.TrimSuffix(strings.TrimPrefix(name, "Create"), "Routes")
+			}
+			return "", ""
+		}
+		switch {
+		case strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Repository"):
+			return "repository", strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Repository")
+		case strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Service"):
+			return "service", strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Service")
+		case strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Handler"):
+			return "handler", strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Handler")
+		case strings.HasSuffix(name, "App") && name != "App" && !strings.HasPrefix(name, "New"):
+			return "app", strings.TrimSuffix(name, "App")
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				name := s.Name.Name
+				switch s.Type.(type) {
+				case *ast.InterfaceType:
+					if strings.HasPrefix(name, "I") && strings.HasSuffix(name, "Repository") {
+						return "port", strings.TrimSuffix(strings.TrimPrefix(name, "I"), "Repository")
+					}
+				case *ast.StructType:
+					if strings.HasSuffix(name, "Domain") && name != "Domain" {
+						return "domain", strings.TrimSuffix(name, "Domain")
+					}
+				}
+			case *ast.ValueSpec:
+				for _, ident := range s.Names {
+					if strings.HasPrefix(ident.Name, "TN") && len(ident.Name) > 2 {
+						return "model", strings.TrimPrefix(ident.Name, "TN")
+					}
+				}
+			}
+		}
+	}
+	return "", ""
+}
+
+// ListFeatures implements ports.IInspectorService.
+func (s *InspectorServiceImpls) ListFeatures(root string) ([]domain.FeatureInventory, error) {
+	_, files, _, err := parseProject(root)
+	if err != nil {
+		return nil, err
+	}
+	m, err := loadManifest(root)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]map[string]string{}
+	for _, f := range files {
+		for _, decl := range f.file.Decls {
+			layer, feature := detectLayer(decl)
+			if layer == "" || feature == "" {
+				continue
+			}
+			if found[feature] == nil {
+				found[feature] = map[string]string{}
+			}
+			found[feature][layer] = f.path
+		}
+	}
+
+	recorded := map[string]domain.ManifestFeature{}
+	for _, f := range m.Features {
+		recorded[f.Name] = f
+		if found[f.Name] == nil {
+			found[f.Name] = map[string]string{}
+		}
+	}
+
+	var inventory []domain.FeatureInventory
+	for name, layers := range found {
+		item := domain.FeatureInventory{
+			Name:   name,
+			Layers: map[string]domain.LayerStatus{},
+			Files:  map[string]string{},
+		}
+		for _, layer := range domain.FeatureLayers {
+			path, ok := layers[layer]
+			entry, isRecorded := recorded[name].Layers[layer]
+			if !ok && isRecorded {
+				// The manifest knows the file even if its declarations were renamed.
+				if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(entry.File))); err == nil {
+					path, ok = filepath.Join(root, filepath.FromSlash(entry.File)), true
+				}
+			}
+			switch {
+			case !ok:
+				item.Layers[layer] = domain.LayerMissing
+			case isRecorded && entry.Checksum != utils.Checksum(domain.Templates[entry.Template]):
+				item.Layers[layer] = domain.LayerOutdated
+				item.Files[layer] = path
+			default:
+				item.Layers[layer] = domain.LayerPresent
+				item.Files[layer] = path
+			}
+		}
+		inventory = append(inventory, item)
+	}
+	sort.Slice(inventory, func(i, j int) bool { return inventory[i].Name < inventory[j].Name })
+	return inventory, nil
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+func TestDetectLayer(t *testing.T) {
+	src := `package order
+
+type OrderDomain struct{}
+type IOrderRepository interface{}
+type Domain struct{}
+
+var TNOrder = "orders"
+
+func NewOrderRepository() {}
+func NewOrderService()    {}
+func NewOrderHandler()    {}
+func OrderApp()           {}
+func NewApp()             {}
+func (h *handler) CreateOrderRoutes() {}
+func (h *handler) Create() {}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "order.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][2]string{
+		{"domain", "Order"}, {"port", "Order"}, {"", ""}, {"model", "Order"},
+		{"repository", "Order"}, {"service", "Order"}, {"handler", "Order"}, {"app", "Order"}, {"", ""},
+		{"route", "Order"}, {"", ""},
+	}
+	if len(file.Decls) != len(want) {
+		t.Fatalf("got %d declarations, want %d", len(file.Decls), len(want))
+	}
+	for i, decl := range file.Decls {
+		if layer, feature := detectLayer(decl); layer != want[i][0] || feature != want[i][1] {
+			t.Errorf("declaration %d: detectLayer = %q, %q, want %q, %q", i, layer, feature, want[i][0], want[i][1])
+		}
+	}
+}
+
+// TestListFeatures generates the model and domain of Order from a subdirectory of a module, writes
+// its port by hand and makes the model template outdated: list of the module root must read the
+// manifest the generators wrote there.
+func TestListFeatures(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+	for _, dir := range []string{"internal/core/domain", "internal/core/ports", "internal/adapters/database/models"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/shop\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(filepath.Join(root, "internal")); err != nil {
+		t.Fatal(err)
+	}
+
+	g := &GeneratorServiceImpls{flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "shop", ORM: "gorm", DB: "postgres"}}
+	g.GenerateModelsFile("adapters/database/models", false)
+	g.GenerateDomainFile("core/domain", false)
+	port := filepath.Join(root, "internal/core/ports/order_ports.go")
+	if err := os.WriteFile(port, []byte("package ports\n\ntype IOrderRepository interface{}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := loadManifest(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	model := m.Features[0].Layers["model"]
+	if model.File != "internal/adapters/database/models/order.go" {
+		t.Errorf("model file = %q, want it relative to the module root", model.File)
+	}
+	model.Checksum = "outdated"
+	m.Features[0].Layers["model"] = model
+	if err := saveManifest(root, m); err != nil {
+		t.Fatal(err)
+	}
+
+	inventory, err := NewInspectorService().ListFeatures(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.FeatureInventory{{
+		Name: "Order",
+		Layers: map[string]domain.LayerStatus{
+			"model": domain.LayerOutdated, "domain": domain.LayerPresent, "port": domain.LayerPresent,
+			"repository": domain.LayerMissing, "service": domain.LayerMissing, "handler": domain.LayerMissing,
+			"route": domain.LayerMissing, "app": domain.LayerMissing,
+		},
+		Files: map[string]string{
+			"model":  filepath.Join(root, "internal/adapters/database/models/order.go"),
+			"domain": filepath.Join(root, "internal/core/domain/order_domain.go"),
+			"port":   port,
+		},
+	}}
+	if !reflect.DeepEqual(inventory, want) {
+		t.Errorf("ListFeatures:\n%+v\nwant:\n%+v", inventory, want)
+	}
+}
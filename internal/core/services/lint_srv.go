@@ -0,0 +1,139 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// classifyPackage returns the layer of the package at rel, a slash separated path relative to the module root.
+// The longest matching layout directory wins, so nested layers can be configured.
+func classifyPackage(layout domain.Layout, rel string) string {
+	best, bestLen := "", -1
+	for layer, dirs := range map[string][]string{
+		"domain":   layout.Domain,
+		"ports":    layout.Ports,
+		"services": layout.Services,
+		"adapters": layout.Adapters,
+	} {
+		for _, dir := range dirs {
+			dir = strings.Trim(filepath.ToSlash(dir), "/")
+			if (rel == dir || strings.HasPrefix(rel, dir+"/")) && len(dir) > bestLen {
+				best, bestLen = layer, len(dir)
+			}
+		}
+	}
+	return best
+}
+
+// allowedImport reports whether a package of layer may import a package of importLayer.
+func allowedImport(layer, importLayer string) bool {
+	if layer == "" || importLayer == "" {
+		return true
+	}
+	for _, allowed := range domain.LayerDependencies[layer] {
+		if allowed == importLayer {
+			return true
+		}
+	}
+	return false
+}
+
+// projectImport returns the path relative to root of an import outside modulePath that names a
+// directory of the project below internal/ or pkg/, as generated code imports it when -project did
+// not match the module path of go.mod.
+func projectImport(root, modulePath, path string) (string, bool) {
+	if path == modulePath || strings.HasPrefix(path, modulePath+"/") {
+		return "", false
+	}
+	for _, marker := range []string{"/internal/", "/pkg/"} {
+		idx := strings.Index(path, marker)
+		if idx < 0 {
+			continue
+		}
+		rel := path[idx+1:]
+		if info, err := os.Stat(filepath.Join(root, filepath.FromSlash(rel))); err == nil && info.IsDir() {
+			return rel, true
+		}
+	}
+	return "", false
+}
+
+// Lint implements ports.IInspectorService. Files that do not parse, and project imports that do not
+// match the module path, are reported as issues with an Error. The latter are checked against the
+// dependency rules as the package they name, rather than passed over as packages of another module.
+func (s *InspectorServiceImpls) Lint(root string) ([]domain.LintIssue, error) {
+	modulePath, err := utils.ReadModulePath(root)
+	if err != nil {
+		return nil, err
+	}
+	m, err := loadManifest(root)
+	if err != nil {
+		return nil, err
+	}
+	layout := domain.DefaultLayout
+	if m.Layout != nil {
+		layout = *m.Layout
+	}
+
+	fset, files, broken, err := parseProject(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []domain.LintIssue
+	for _, e := range broken {
+		// The imports of a file that does not parse cannot be checked.
+		issues = append(issues, domain.LintIssue{File: e.Pos.Filename, Line: e.Pos.Line, Column: e.Pos.Column, Error: "cannot parse: " + e.Msg})
+	}
+	for _, f := range files {
+		rel, err := filepath.Rel(root, filepath.Dir(f.path))
+		if err != nil {
+			return nil, err
+		}
+		layer := classifyPackage(layout, filepath.ToSlash(rel))
+		for _, imp := range f.file.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				continue
+			}
+			pos := fset.Position(imp.Pos())
+			importRel := strings.TrimPrefix(strings.TrimPrefix(path, modulePath), "/")
+			if path != modulePath && !strings.HasPrefix(path, modulePath+"/") {
+				var ok bool
+				if importRel, ok = projectImport(root, modulePath, path); !ok {
+					continue
+				}
+				issues = append(issues, domain.LintIssue{
+					File: pos.Filename, Line: pos.Line, Column: pos.Column, Import: path,
+					Error: fmt.Sprintf("import %q does not match module %q of go.mod", path, modulePath),
+				})
+			}
+			importLayer := classifyPackage(layout, importRel)
+			if layer == "" || allowedImport(layer, importLayer) {
+				continue
+			}
+			issues = append(issues, domain.LintIssue{
+				File:        pos.Filename,
+				Line:        pos.Line,
+				Column:      pos.Column,
+				Layer:       layer,
+				Import:      path,
+				ImportLayer: importLayer,
+			})
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		return issues[i].Line < issues[j].Line
+	})
+	return issues, nil
+}
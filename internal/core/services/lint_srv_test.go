@@ -0,0 +1,86 @@
+package services
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+func TestClassifyPackage(t *testing.T) {
+	layout := domain.Layout{
+		Domain:   []string{"internal/core/domain"},
+		Ports:    []string{"internal/core/ports"},
+		Services: []string{"internal/core/services"},
+		Adapters: []string{"internal", "cmd/"},
+	}
+	tests := []struct {
+		rel  string
+		want string
+	}{
+		{"internal/core/domain", "domain"},
+		{"internal/core/domain/money", "domain"},
+		{"internal/core/ports", "ports"},
+		{"internal/core/services", "services"},
+		{"internal/adapters/database", "adapters"},
+		{"internal/core", "adapters"}, // the longest matching directory wins
+		{"cmd", "adapters"},
+		{"internal/core/domainx", "adapters"},
+		{"pkg/clock", ""},
+		{".", ""},
+	}
+	for _, tt := range tests {
+		if got := classifyPackage(layout, tt.rel); got != tt.want {
+			t.Errorf("classifyPackage(%q) = %q, want %q", tt.rel, got, tt.want)
+		}
+	}
+}
+
+func TestAllowedImport(t *testing.T) {
+	tests := []struct {
+		layer, importLayer string
+		want               bool
+	}{
+		{"domain", "domain", true},
+		{"domain", "ports", false},
+		{"ports", "domain", true},
+		{"ports", "services", false},
+		{"services", "ports", true},
+		{"services", "adapters", false},
+		{"adapters", "services", true},
+		{"adapters", "adapters", true},
+		{"domain", "", true},
+		{"", "adapters", true},
+	}
+	for _, tt := range tests {
+		if got := allowedImport(tt.layer, tt.importLayer); got != tt.want {
+			t.Errorf("allowedImport(%q, %q) = %v, want %v", tt.layer, tt.importLayer, got, tt.want)
+		}
+	}
+}
+
+// TestLint lints testdata/lint, where the domain, ports and services packages each make one
+// allowed and one forbidden import, the adapters import services and a package outside the
+// layout, and one file does not parse. The domain also imports the adapters with the module path of
+// a mismatched -project, which must be reported rather than passed over as another module.
+func TestLint(t *testing.T) {
+	root := filepath.Join("testdata", "lint")
+	issues, err := NewInspectorService().Lint(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := func(rel string) string { return filepath.Join(root, filepath.FromSlash(rel)) }
+	want := []domain.LintIssue{
+		{File: file("internal/adapters/database/broken.go"), Line: 3, Column: 14, Error: "cannot parse: expected ')', found '{'"},
+		{File: file("internal/core/domain/invoice.go"), Line: 4, Column: 8, Import: "github.com/example.com/shop/internal/adapters/database",
+			Error: `import "github.com/example.com/shop/internal/adapters/database" does not match module "example.com/shop" of go.mod`},
+		{File: file("internal/core/domain/invoice.go"), Line: 4, Column: 8, Layer: "domain", Import: "github.com/example.com/shop/internal/adapters/database", ImportLayer: "adapters"},
+		{File: file("internal/core/domain/order.go"), Line: 5, Column: 2, Layer: "domain", Import: "example.com/shop/internal/core/ports", ImportLayer: "ports"},
+		{File: file("internal/core/ports/order_ports.go"), Line: 5, Column: 2, Layer: "ports", Import: "example.com/shop/internal/core/services", ImportLayer: "services"},
+		{File: file("internal/core/services/order_srv.go"), Line: 4, Column: 2, Layer: "services", Import: "example.com/shop/internal/adapters/database", ImportLayer: "adapters"},
+	}
+	if !reflect.DeepEqual(issues, want) {
+		t.Errorf("Lint issues:\n%+v\nwant:\n%+v", issues, want)
+	}
+}
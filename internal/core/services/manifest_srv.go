@@ -0,0 +1,147 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/configs"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// loadManifest reads the manifest in root. A missing manifest yields an empty one.
+func loadManifest(root string) (*domain.Manifest, error) {
+	content, err := os.ReadFile(filepath.Join(root, domain.ManifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return &domain.Manifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m domain.Manifest
+	if err := json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", domain.ManifestFileName, err)
+	}
+	return &m, nil
+}
+
+// saveManifest writes the manifest to root.
+func saveManifest(root string, m *domain.Manifest) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(root, domain.ManifestFileName), append(content, '\n'), 0644)
+}
+
+// manifestFeature returns the manifest entry for name, adding one if needed.
+func manifestFeature(m *domain.Manifest, name string) *domain.ManifestFeature {
+	for i := range m.Features {
+		if m.Features[i].Name == name {
+			return &m.Features[i]
+		}
+	}
+	m.Features = append(m.Features, domain.ManifestFeature{Name: name})
+	return &m.Features[len(m.Features)-1]
+}
+
+// ProjectORM returns the persistence library of the project in root when -orm is not given: the orm
+// key of the manifest, else the ORM environment variable, else DB_ADAPTER when it names a library of
+// domain.ORMs, else the default of domain.ORMs.
+func ProjectORM(root string) string {
+	if m, err := loadManifest(root); err == nil && m.ORM != "" {
+		return m.ORM
+	}
+	if orm := strings.ToLower(strings.TrimSpace(configs.ORM)); orm != "" {
+		return orm
+	}
+	if adapter := strings.ToLower(strings.TrimSpace(configs.DB_ADAPTER)); slices.Contains(domain.ORMs, adapter) {
+		return adapter
+	}
+	return domain.ORMs[0]
+}
+
+// ProjectDatabase returns the database of the project in root when -db is not given: the database
+// key of the manifest, else the DATABASE environment variable, else mongo when DB_ADAPTER names it,
+// else the default of domain.Databases. Aliases such as postgresql or mariadb are resolved.
+func ProjectDatabase(root string) string {
+	if m, err := loadManifest(root); err == nil && m.Database != "" {
+		return m.Database
+	}
+	if db := NormalizeDatabase(configs.DATABASE); db != "" {
+		return db
+	}
+	if NormalizeDatabase(configs.DB_ADAPTER) == "mongo" {
+		return "mongo"
+	}
+	return domain.Databases[0]
+}
+
+// NormalizeDatabase lowercases name and resolves it through domain.DatabaseAliases.
+func NormalizeDatabase(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if alias, ok := domain.DatabaseAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// ProjectRoot returns the directory of the project manifest: the module root of the working
+// directory, which list, lint and doctor read it from with -dir, or the working directory outside
+// a module.
+func ProjectRoot() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "."
+	}
+	if root, ok := utils.FindModuleRoot(wd); ok {
+		return root
+	}
+	return wd
+}
+
+// manifestPath returns filePath, relative to the working directory, as a slash separated path
+// relative to root.
+func manifestPath(root, filePath string) string {
+	if abs, err := filepath.Abs(filePath); err == nil {
+		if rel, err := filepath.Rel(root, abs); err == nil {
+			filePath = rel
+		}
+	}
+	return filepath.ToSlash(filePath)
+}
+
+// recordLayer notes in the project manifest that a layer of the current feature was generated. The
+// orm and database keys are only recorded from an explicit -orm and -db, so that defaulted ones do
+// not shadow the ORM, DATABASE and DB_ADAPTER environment variables afterwards.
+func (g *GeneratorServiceImpls) recordLayer(layer, templateKey, filePath string) {
+	root := ProjectRoot()
+	m, err := loadManifest(root)
+	if err != nil {
+		fmt.Printf("Error reading manifest: %v\n", err)
+		return
+	}
+	if m.ORM == "" && g.flag.ORMSet {
+		m.ORM = g.flag.ORM
+	}
+	if m.Database == "" && g.flag.DBSet {
+		m.Database = g.flag.DB
+	}
+	feature := manifestFeature(m, g.flag.FeatureName)
+	if feature.Layers == nil {
+		feature.Layers = map[string]domain.ManifestLayer{}
+	}
+	feature.Layers[layer] = domain.ManifestLayer{
+		File:     manifestPath(root, filePath),
+		Template: templateKey,
+		Checksum: utils.Checksum(domain.Templates[templateKey]),
+	}
+	if err := saveManifest(root, m); err != nil {
+		fmt.Printf("Error writing manifest: %v\n", err)
+	}
+}
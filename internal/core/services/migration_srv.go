@@ -0,0 +1,301 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// migrationData returns the data of the migration templates. Without a previous schema the table is
+// created, else it is altered from previous to the current fields.
+func (g *GeneratorServiceImpls) migrationData(previous []domain.ManifestColumn) domain.MigrationFlagDomain {
+	var columns []domain.MigrationColumn
+	for _, f := range g.fields() {
+		columns = append(columns, g.migrationColumn(f))
+	}
+	data := domain.MigrationFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		Table:       g.table(),
+		UseUUID:     g.flag.UseUUID,
+		SoftDelete:  g.persistence() == "gorm",
+		Dialect:     g.dialect(),
+		Columns:     columns,
+	}
+	if previous != nil {
+		var before []domain.MigrationColumn
+		for _, c := range previous {
+			before = append(before, g.migrationColumn(domain.Field{Column: c.Column, Type: c.Type, Index: c.Index, References: c.References, Nullable: c.Nullable, Default: c.Default}))
+		}
+		renames := map[string]string{} // current name -> previous name
+		for _, f := range g.fields() {
+			if f.Renamed != "" {
+				renames[f.Column] = f.Renamed
+			}
+		}
+		undo := map[string]string{}
+		for column, was := range renames {
+			undo[was] = column
+		}
+		restate := data.Dialect.Restate
+		data.AlterUp = &domain.MigrationChanges{Table: data.Table, Dialect: data.Dialect, Changes: diffColumns(before, columns, renames, restate)}
+		data.AlterDown = &domain.MigrationChanges{Table: data.Table, Dialect: data.Dialect, Changes: diffColumns(columns, before, undo, restate)}
+	}
+	return data
+}
+
+// migrationColumn returns the column of a field typed for the dialect of -db.
+func (g *GeneratorServiceImpls) migrationColumn(f domain.Field) domain.MigrationColumn {
+	dialect := g.dialect()
+	column := domain.MigrationColumn{
+		Name:       f.Column,
+		Type:       dialect.Types[f.Type],
+		Index:      f.Index,
+		References: f.References,
+		Nullable:   f.Nullable,
+		Default:    f.Default,
+	}
+	if f.References != "" && f.Type == "string" {
+		column.Type = dialect.UUIDType // string references are to -uuid IDs
+	}
+	switch {
+	case f.Nullable || f.Default != "":
+		// the existing rows are filled with NULL or the default
+	case f.References != "":
+		// no value satisfies the foreign key, the existing rows need one set by hand
+	case f.Type == "string":
+		column.Fill = "''"
+	case f.Type == "bool":
+		column.Fill = "FALSE"
+	case f.Type == "time.Time":
+		column.Fill = dialect.Now
+		if dialect.Name == "sqlite" {
+			column.Fill = "'1970-01-01 00:00:00'" // SQLite only adds columns with a constant default
+		}
+	default:
+		column.Fill = "0"
+	}
+	return column
+}
+
+// diffColumns returns the changes from the before to the after columns, in the order of
+// domain.MigrationChangeKinds. renames maps the name of a column in after to its name in before.
+// The index and the foreign key of a renamed column are recreated with its new name. With restate,
+// the alter of a retyped column also sets whether it accepts NULL and its default.
+func diffColumns(before, after []domain.MigrationColumn, renames map[string]string, restate bool) []domain.MigrationChange {
+	changes := map[string][]domain.MigrationChange{}
+	add := func(kind string, column domain.MigrationColumn, previous string) {
+		changes[kind] = append(changes[kind], domain.MigrationChange{Kind: kind, Column: column, Previous: previous})
+	}
+	alter := func(kind string, column, from domain.MigrationColumn) {
+		changes[kind] = append(changes[kind], domain.MigrationChange{Kind: kind, Column: column, From: from})
+	}
+	previous := map[string]domain.MigrationColumn{}
+	for _, c := range before {
+		previous[c.Name] = c
+	}
+	kept := map[string]bool{}
+	for _, c := range after {
+		was := c.Name
+		if _, ok := previous[c.Name]; !ok && renames[c.Name] != "" {
+			was = renames[c.Name] // a rename already migrated is left as is
+		}
+		p, ok := previous[was]
+		if !ok {
+			add("add", c, "")
+			if c.Index != "" {
+				add("add_index", c, "")
+			}
+			if c.References != "" {
+				add("add_foreign_key", c, "")
+			}
+			continue
+		}
+		kept[was] = true
+		renamed := was != c.Name
+		if p.References != "" && (renamed || p.References != c.References) {
+			add("drop_foreign_key", p, "")
+		}
+		if p.Index != "" && (renamed || p.Index != c.Index) {
+			add("drop_index", p, "")
+		}
+		if renamed {
+			add("rename", c, was)
+		}
+		retyped := p.Type != c.Type
+		if retyped {
+			alter("alter", c, p)
+		}
+		if (p.Nullable != c.Nullable || p.Default != c.Default) && !(retyped && restate) {
+			alter("modify", c, p)
+		}
+		if c.Index != "" && (renamed || p.Index != c.Index) {
+			add("add_index", c, "")
+		}
+		if c.References != "" && (renamed || p.References != c.References) {
+			add("add_foreign_key", c, "")
+		}
+	}
+	for _, p := range before {
+		if kept[p.Name] {
+			continue
+		}
+		if p.References != "" {
+			add("drop_foreign_key", p, "")
+		}
+		if p.Index != "" {
+			add("drop_index", p, "")
+		}
+		add("drop", p, "")
+	}
+
+	var ordered []domain.MigrationChange
+	for _, kind := range domain.MigrationChangeKinds {
+		ordered = append(ordered, changes[kind]...)
+	}
+	return ordered
+}
+
+// schemaColumns returns the columns of fields as recorded in the manifest.
+func schemaColumns(fields []domain.Field) []domain.ManifestColumn {
+	var columns []domain.ManifestColumn
+	for _, f := range fields {
+		columns = append(columns, domain.ManifestColumn{Column: f.Column, Type: f.Type, Index: f.Index, References: f.References, Nullable: f.Nullable, Default: f.Default})
+	}
+	return columns
+}
+
+// recordedSchema returns the schema of the feature recorded in the manifest by its last migration,
+// or nil.
+func (g *GeneratorServiceImpls) recordedSchema() []domain.ManifestColumn {
+	m, err := loadManifest(ProjectRoot())
+	if err != nil {
+		return nil
+	}
+	for _, f := range m.Features {
+		if f.Name == g.flag.FeatureName {
+			return f.Schema
+		}
+	}
+	return nil
+}
+
+// migrationTemplate and migrationDownTemplate return the template key, source and data of the up
+// and down migrations, in the format selected with -migration. goose has no down file and -db mongo
+// no migrations, for which the key is empty.
+func (g *GeneratorServiceImpls) migrationTemplate() (string, string, any) {
+	return g.migrationVariant("migration")
+}
+
+func (g *GeneratorServiceImpls) migrationDownTemplate() (string, string, any) {
+	return g.migrationVariant("migration_down")
+}
+
+func (g *GeneratorServiceImpls) migrationVariant(key string) (string, string, any) {
+	if g.flag.DB == "mongo" {
+		return "", "", nil
+	}
+	key, text := variantTemplate(key, g.flag.Migration, domain.MigrationFormats)
+	return key, text, g.migrationData(g.recordedSchema())
+}
+
+// GenerateMigrationFiles implements ports.IGeneratorService.
+func (g *GeneratorServiceImpls) GenerateMigrationFiles(dir string) {
+	if g.flag.DB == "mongo" {
+		fmt.Println("Migrations are SQL; -db mongo creates its collections on the first insert.")
+		return
+	}
+	defaultDir := "./migrations"
+	err := utils.EnsureDir(dir, defaultDir)
+	if err != nil {
+		fmt.Printf("Failed to ensure directory: %v", err)
+	}
+
+	previous := g.recordedSchema()
+	if previous != nil && len(g.flag.Fields) == 0 {
+		fmt.Printf("Pass the fields of %s with -fields to diff them against its last migration.\n", g.flag.FeatureName)
+		return
+	}
+	action := "create"
+	if data := g.migrationData(previous); data.AlterUp != nil {
+		if len(data.AlterUp.Changes) == 0 {
+			fmt.Printf("The fields of %s have not changed since its last migration.\n", g.flag.FeatureName)
+			return
+		}
+		action = "alter"
+		for _, change := range data.AlterUp.Changes {
+			if slices.Contains(domain.DestructiveChanges, change.Kind) {
+				fmt.Printf("Warning: the migration %ss column %s of %s, which may lose data. Review it before applying it.\n", change.Kind, change.Column.Name, data.Table)
+			}
+		}
+	}
+
+	name := migrationVersion(dir, time.Now()) + "_" + action + "_" + g.table() + "_table"
+	upName, downName := name+".up.sql", name+".down.sql"
+	if g.flag.Migration == "goose" {
+		upName = name + ".sql"
+	}
+	if !g.writeMigration(dir, "migration", upName, g.migrationTemplate) {
+		return
+	}
+	g.writeMigration(dir, "migration_down", downName, g.migrationDownTemplate)
+	g.RecordSchema()
+}
+
+// migrationVersion returns the version prefix of a migration written to dir at now. golang-migrate
+// and goose both order the files by it, so it is after the versions of the files already in dir.
+func migrationVersion(dir string, now time.Time) string {
+	version, _ := strconv.ParseInt(now.UTC().Format("20060102150405"), 10, 64)
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		prefix, _, _ := strings.Cut(entry.Name(), "_")
+		if v, err := strconv.ParseInt(prefix, 10, 64); err == nil && v >= version {
+			version = v + 1
+		}
+	}
+	return strconv.FormatInt(version, 10)
+}
+
+// RecordSchema implements ports.IGeneratorService. It notes the columns of the current fields in
+// the manifest, for the next migration to diff against, e.g. for a table that already exists.
+func (g *GeneratorServiceImpls) RecordSchema() {
+	root := ProjectRoot()
+	m, err := loadManifest(root)
+	if err != nil {
+		fmt.Printf("Error reading manifest: %v\n", err)
+		return
+	}
+	manifestFeature(m, g.flag.FeatureName).Schema = schemaColumns(g.fields())
+	if err := saveManifest(root, m); err != nil {
+		fmt.Printf("Error writing manifest: %v\n", err)
+	}
+}
+
+// writeMigration renders a migration template to dir and records it as layer. Templates with an
+// empty key are skipped. It reports whether no error occurred.
+func (g *GeneratorServiceImpls) writeMigration(dir, layer, fileName string, template func() (string, string, any)) bool {
+	templateKey, templateText, data := template()
+	if templateKey == "" {
+		return true
+	}
+	content, err := renderTemplate(templateKey, templateText, data)
+	if err != nil {
+		fmt.Printf("Error parsing template: %v\n", err)
+		return false
+	}
+
+	filePath := filepath.Join(dir, fileName)
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		fmt.Printf("Error writing to file: %v\n", err)
+		return false
+	}
+	fmt.Printf("Migration file '%s' created successfully!\n", filePath)
+	g.recordLayer(layer, templateKey, filePath)
+	return true
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +10,20 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// modelTemplate returns the template key, source and data of the model file.
+func (g *GeneratorServiceImpls) modelTemplate(useUUID bool) (string, string, any) {
+	data := domain.ModelFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		UseUUID:     useUUID,
+		Fields:      g.fields(),
+		Dialect:     g.dialect(),
+		Table:       g.table(),
+	}
+	key, text := g.ormTemplate("model")
+	return key, text, data
+}
+
 // GenerateModelsFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateModelsFile(dir string, useUUID bool) {
 	// Default to current directory if not provided
@@ -20,17 +33,9 @@ func (g *GeneratorServiceImpls) GenerateModelsFile(dir string, useUUID bool) {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
 
-	// Prepare the data for template rendering
-	data := domain.ModelFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-		UseUUID:     useUUID,
-	}
-
-	// Parse and execute the template
-	tmpl, err := template.New("models").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.ModelsTemplate)
+	// Render the template
+	templateKey, templateText, data := g.modelTemplate(useUUID)
+	content, err := renderTemplate("models", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -40,19 +45,11 @@ func (g *GeneratorServiceImpls) GenerateModelsFile(dir string, useUUID bool) {
 	fileName := fmt.Sprintf("%s.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Model file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Model file '%s' created successfully!\n", filePath)
+	g.recordLayer("model", templateKey, filePath)
 }
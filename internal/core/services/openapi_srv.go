@@ -0,0 +1,506 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// openAPIDocument is the part of an OpenAPI 3 document features are imported from.
+type openAPIDocument struct {
+	OpenAPI    string       `yaml:"openapi"`
+	Swagger    string       `yaml:"swagger"`
+	Paths      openAPIPaths `yaml:"paths"`
+	Components struct {
+		Schemas map[string]openAPISchema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+// openAPIPaths keeps the paths of a document in their order.
+type openAPIPaths []openAPIPath
+
+type openAPIPath struct {
+	Path string
+	Item openAPIPathItem
+}
+
+type openAPIPathItem struct {
+	Get    *openAPIOperation `yaml:"get"`
+	Post   *openAPIOperation `yaml:"post"`
+	Put    *openAPIOperation `yaml:"put"`
+	Patch  *openAPIOperation `yaml:"patch"`
+	Delete *openAPIOperation `yaml:"delete"`
+}
+
+type openAPIOperation struct {
+	OperationID string `yaml:"operationId"`
+	Summary     string `yaml:"summary"`
+	RequestBody *struct {
+		Ref     string                    `yaml:"$ref"`
+		Content map[string]openAPIContent `yaml:"content"`
+	} `yaml:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]openAPIContent `yaml:"content"`
+	} `yaml:"responses"`
+}
+
+type openAPIContent struct {
+	Schema openAPISchema `yaml:"schema"`
+}
+
+// openAPISchema is a JSON schema, with the constraints fields can carry. The bounds, enum values and
+// default are kept as written.
+type openAPISchema struct {
+	Ref        string            `yaml:"$ref"`
+	Type       yaml.Node         `yaml:"type"` // a type or, since OpenAPI 3.1, a list of types
+	Format     string            `yaml:"format"`
+	Nullable   bool              `yaml:"nullable"`
+	Enum       []string          `yaml:"enum"`
+	Pattern    string            `yaml:"pattern"`
+	MinLength  string            `yaml:"minLength"`
+	MaxLength  string            `yaml:"maxLength"`
+	Minimum    string            `yaml:"minimum"`
+	Maximum    string            `yaml:"maximum"`
+	Default    yaml.Node         `yaml:"default"`
+	Required   []string          `yaml:"required"`
+	Properties openAPIProperties `yaml:"properties"`
+	Items      *openAPISchema    `yaml:"items"`
+	AllOf      []openAPISchema   `yaml:"allOf"`
+}
+
+// openAPIProperties keeps the properties of a schema in their order.
+type openAPIProperties []openAPIProperty
+
+type openAPIProperty struct {
+	Name   string
+	Schema openAPISchema
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (p *openAPIPaths) UnmarshalYAML(n *yaml.Node) error {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		path := openAPIPath{Path: n.Content[i].Value}
+		if err := n.Content[i+1].Decode(&path.Item); err != nil {
+			return fmt.Errorf("path %s: %v", path.Path, err)
+		}
+		*p = append(*p, path)
+	}
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (p *openAPIProperties) UnmarshalYAML(n *yaml.Node) error {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		property := openAPIProperty{Name: n.Content[i].Value}
+		if err := n.Content[i+1].Decode(&property.Schema); err != nil {
+			return fmt.Errorf("property %s: %v", property.Name, err)
+		}
+		*p = append(*p, property)
+	}
+	return nil
+}
+
+// types returns the type of the schema besides null, and whether it is nullable.
+func (s openAPISchema) types() (string, bool) {
+	switch s.Type.Kind {
+	case yaml.ScalarNode:
+		return s.Type.Value, s.Nullable
+	case yaml.SequenceNode:
+		var typ string
+		nullable := s.Nullable
+		for _, t := range s.Type.Content {
+			if t.Value == "null" {
+				nullable = true
+			} else {
+				typ = t.Value
+			}
+		}
+		return typ, nullable
+	}
+	return "", s.Nullable
+}
+
+// operation returns the operation of the path item for method, one of domain.OperationMethods.
+func (p openAPIPathItem) operation(method string) *openAPIOperation {
+	switch method {
+	case "GET":
+		return p.Get
+	case "POST":
+		return p.Post
+	case "PUT":
+		return p.Put
+	case "PATCH":
+		return p.Patch
+	case "DELETE":
+		return p.Delete
+	}
+	return nil
+}
+
+// openAPIParam matches a path parameter, e.g. {petId}.
+var openAPIParam = regexp.MustCompile(`^\{[^}]+\}$`)
+
+// openAPIMethodName matches the operation IDs that are Go method names once camel-cased.
+var openAPIMethodName = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// openAPIName matches the property names that are Go identifiers once camel-cased.
+var openAPIName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// openAPIEndpoint is an operation of a resource, with the path below the one of the resource.
+type openAPIEndpoint struct {
+	Method    string
+	Path      string // e.g. /{petId}/adopt
+	Operation *openAPIOperation
+}
+
+// ParseOpenAPI implements ports.IImportService. The paths are grouped by their first segment, after
+// the segments all of them start with, such as /api/v1. Each group with a schema of its items, the
+// one of the body of its POST or PUT or else of the response of its GET, becomes a feature named
+// after the singular of the segment. The CRUD endpoints are the generated ones and the others become
+// operations; the groups and properties gohexa cannot generate are skipped with a warning.
+func (s *ImportServiceImpls) ParseOpenAPI(spec []byte) ([]domain.FeatureSpec, []string, error) {
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(spec, &doc); err != nil {
+		return nil, nil, err
+	}
+	if doc.Swagger != "" || !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, nil, fmt.Errorf("only OpenAPI 3 documents are supported")
+	}
+	resolver := openAPIResolver{schemas: doc.Components.Schemas}
+
+	var warnings []string
+	var segments []string
+	endpoints := map[string][]openAPIEndpoint{}
+	prefix := openAPIPrefix(doc.Paths)
+	for _, path := range doc.Paths {
+		parts := strings.Split(strings.Trim(path.Path, "/"), "/")[prefix:]
+		if parts[0] == "" || openAPIParam.MatchString(parts[0]) {
+			warnings = append(warnings, fmt.Sprintf("skipping path %s: it does not start with a resource", path.Path))
+			continue
+		}
+		if !slices.Contains(segments, parts[0]) {
+			segments = append(segments, parts[0])
+		}
+		rest := ""
+		if len(parts) > 1 {
+			rest = "/" + strings.Join(parts[1:], "/")
+		}
+		for _, method := range domain.OperationMethods {
+			if op := path.Item.operation(method); op != nil {
+				endpoints[parts[0]] = append(endpoints[parts[0]], openAPIEndpoint{Method: method, Path: rest, Operation: op})
+			}
+		}
+	}
+
+	var specs []domain.FeatureSpec
+	for _, segment := range segments {
+		table := utils.ToSnake(segment)
+		spec := domain.FeatureSpec{Feature: utils.ToCamel(utils.Singularize(table)), Table: table}
+		if segment != strings.ToLower(utils.Pluralize(spec.Feature)) {
+			spec.Path = segment
+		}
+		name, schema, ok := resolver.itemSchema(endpoints[segment])
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("skipping resource /%s: none of its endpoints has the schema of its items", segment))
+			continue
+		}
+		fields, uuid, fieldWarnings := resolver.fields(spec.Feature, schema)
+		warnings = append(warnings, fieldWarnings...)
+		if len(fields) == 0 {
+			warnings = append(warnings, fmt.Sprintf("skipping resource /%s: its schema has no properties besides the generated ones", segment))
+			continue
+		}
+		spec.UUID = uuid
+		spec.Fields = strings.Join(fields, ",")
+		spec.Operations, warnings = openAPIOperations(spec.Feature, segment, name, endpoints[segment], warnings)
+		specs = append(specs, spec)
+	}
+	return specs, warnings, nil
+}
+
+// openAPIPrefix returns the number of leading segments all paths share, as long as every path has
+// a segment after them.
+func openAPIPrefix(paths openAPIPaths) int {
+	if len(paths) == 0 {
+		return 0
+	}
+	split := make([][]string, len(paths))
+	for i, path := range paths {
+		split[i] = strings.Split(strings.Trim(path.Path, "/"), "/")
+	}
+	for n := 0; ; n++ {
+		for _, parts := range split {
+			if len(parts) <= n+1 || parts[n] != split[0][n] || openAPIParam.MatchString(parts[n]) {
+				return n
+			}
+		}
+	}
+}
+
+// openAPICRUD returns the CRUD method generated for an endpoint, e.g. Get for GET /{id}, if any.
+func openAPICRUD(e openAPIEndpoint) string {
+	switch {
+	case e.Path == "" && e.Method == "GET":
+		return "List"
+	case e.Path == "" && e.Method == "POST":
+		return "Create"
+	case openAPIParam.MatchString(strings.TrimPrefix(e.Path, "/")) && e.Method == "GET":
+		return "Get"
+	case openAPIParam.MatchString(strings.TrimPrefix(e.Path, "/")) && e.Method == "PUT":
+		return "Update"
+	case openAPIParam.MatchString(strings.TrimPrefix(e.Path, "/")) && e.Method == "DELETE":
+		return "Delete"
+	}
+	return ""
+}
+
+// openAPIOperations returns the operations of the endpoints that are not CRUD ones. schemaName is
+// the name of the schema of the items, whose bodies are the domain of the feature.
+func openAPIOperations(feature, segment, schemaName string, endpoints []openAPIEndpoint, warnings []string) ([]domain.Operation, []string) {
+	taken := []string{"Get" + feature, "Get" + feature + "s", "Create" + feature, "Update" + feature, "Delete" + feature}
+	var operations []domain.Operation
+	for _, e := range endpoints {
+		if openAPICRUD(e) != "" {
+			continue
+		}
+		where := fmt.Sprintf("%s /%s%s", e.Method, segment, e.Path)
+		var literals []string
+		path := ""
+		params := 0
+		for i, part := range strings.Split(strings.TrimPrefix(e.Path, "/"), "/") {
+			switch {
+			case part == "":
+			case openAPIParam.MatchString(part) && i == 0:
+				path += "/{id}"
+				params++
+			case openAPIParam.MatchString(part):
+				params++
+			default:
+				path += "/" + part
+				literals = append(literals, part)
+			}
+		}
+		if params > 1 || (params == 1 && !strings.HasPrefix(path, "/{id}")) {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: only the ID of the feature can be a path parameter", where))
+			continue
+		}
+		op := domain.Operation{Method: e.Method, Path: path, Summary: strings.TrimSpace(e.Operation.Summary)}
+		switch {
+		case openAPIMethodName.MatchString(utils.ToCamel(e.Operation.OperationID)):
+			op.Name = utils.ToCamel(e.Operation.OperationID)
+		case len(literals) == 0:
+			op.Name = utils.ToCamel(strings.ToLower(e.Method)) + feature
+		case op.HasID():
+			op.Name = utils.ToCamel(strings.Join(literals, "_")) + feature
+		default:
+			op.Name = utils.ToCamel(strings.Join(literals, "_")) + utils.Pluralize(feature)
+		}
+		if slices.Contains(taken, op.Name) {
+			warnings = append(warnings, fmt.Sprintf("skipping %s: %s is taken by another method of the feature", where, op.Name))
+			continue
+		}
+		taken = append(taken, op.Name)
+		if body := e.Operation.RequestBody; body != nil {
+			op.Body = "map"
+			if content, ok := openAPIJSON(body.Content); ok && schemaName != "" && content.Schema.Ref == "#/components/schemas/"+schemaName {
+				op.Body = "domain"
+			}
+		}
+		operations = append(operations, op)
+	}
+	return operations, warnings
+}
+
+// openAPIJSON returns the JSON content of a body or response.
+func openAPIJSON(content map[string]openAPIContent) (openAPIContent, bool) {
+	if c, ok := content["application/json"]; ok {
+		return c, true
+	}
+	for mediaType, c := range content {
+		if strings.HasSuffix(mediaType, "+json") {
+			return c, true
+		}
+	}
+	return openAPIContent{}, false
+}
+
+// openAPIResolver resolves the references to the schemas of the components of a document.
+type openAPIResolver struct {
+	schemas map[string]openAPISchema
+}
+
+// resolve returns the schema s refers to, with its allOf merged, and the name of the component.
+func (r openAPIResolver) resolve(s openAPISchema) (string, openAPISchema) {
+	var name string
+	for depth := 0; s.Ref != "" && depth < 8; depth++ {
+		component, ok := strings.CutPrefix(s.Ref, "#/components/schemas/")
+		if !ok {
+			return "", openAPISchema{}
+		}
+		name, s = component, r.schemas[component]
+	}
+	for _, part := range s.AllOf {
+		_, part = r.resolve(part)
+		for _, p := range part.Properties {
+			if !slices.ContainsFunc(s.Properties, func(q openAPIProperty) bool { return q.Name == p.Name }) {
+				s.Properties = append(s.Properties, p)
+			}
+		}
+		s.Required = append(s.Required, part.Required...)
+	}
+	return name, s
+}
+
+// itemSchema returns the schema of the items of a resource: the body of its POST or PUT, or else the
+// response of its GET of an item or the items of the response of its GET of the list.
+func (r openAPIResolver) itemSchema(endpoints []openAPIEndpoint) (string, openAPISchema, bool) {
+	var candidates []openAPISchema
+	for _, crud := range []string{"Create", "Update", "Get", "List"} {
+		for _, e := range endpoints {
+			if openAPICRUD(e) != crud {
+				continue
+			}
+			switch crud {
+			case "Create", "Update":
+				if e.Operation.RequestBody != nil {
+					if c, ok := openAPIJSON(e.Operation.RequestBody.Content); ok {
+						candidates = append(candidates, c.Schema)
+					}
+				}
+			case "Get", "List":
+				for _, code := range []string{"200", "201", "2XX", "default"} {
+					c, ok := openAPIJSON(e.Operation.Responses[code].Content)
+					if !ok {
+						continue
+					}
+					schema := c.Schema
+					if crud == "List" {
+						if _, list := r.resolve(schema); list.Items != nil {
+							schema = *list.Items
+						}
+					}
+					candidates = append(candidates, schema)
+					break
+				}
+			}
+		}
+	}
+	for _, candidate := range candidates {
+		name, schema := r.resolve(candidate)
+		if typ, _ := schema.types(); (typ == "object" || typ == "") && len(schema.Properties) > 0 {
+			return name, schema, true
+		}
+	}
+	return "", openAPISchema{}, false
+}
+
+// fields returns the properties of the schema of a feature in the syntax of -fields, and whether
+// its id is a string.
+func (r openAPIResolver) fields(feature string, schema openAPISchema) ([]string, bool, []string) {
+	var fields, warnings []string
+	var uuid bool
+	for _, p := range schema.Properties {
+		where := feature + "." + p.Name
+		if !openAPIName.MatchString(p.Name) {
+			warnings = append(warnings, fmt.Sprintf("skipping property %s: its name is not an identifier", where))
+			continue
+		}
+		_, property := r.resolve(p.Schema)
+		typ, nullable := property.types()
+		column := utils.ToSnake(p.Name)
+		if column == "id" {
+			uuid = typ == "string"
+			continue
+		}
+		if generatedColumns[column] {
+			continue
+		}
+		goType := openAPIType(typ, property.Format)
+		if goType == "" {
+			if typ == "" {
+				typ = "object"
+			}
+			warnings = append(warnings, fmt.Sprintf("skipping property %s: unsupported type %s", where, typ))
+			continue
+		}
+		field := newImportedField(column, goType, "property "+where, &warnings)
+		add := field.add
+		if slices.Contains(schema.Required, p.Name) && (goType == "string" || goType == "time") {
+			add("required")
+		}
+		if nullable {
+			add("null")
+		}
+		if typ == "string" {
+			if property.MinLength != "" {
+				add("min=" + property.MinLength)
+			}
+			if property.MaxLength != "" {
+				add("max=" + property.MaxLength)
+			}
+		} else {
+			if property.Minimum != "" {
+				add("min=" + property.Minimum)
+			}
+			if property.Maximum != "" {
+				add("max=" + property.Maximum)
+			}
+		}
+		if len(property.Enum) > 0 && goType == "string" {
+			if slices.ContainsFunc(property.Enum, func(v string) bool { return v == "" || strings.Contains(v, "|") }) {
+				warnings = append(warnings, fmt.Sprintf("skipping the enum of property %s: a value is empty or has a |", where))
+			} else {
+				add("enum=" + strings.Join(property.Enum, "|"))
+			}
+		}
+		if slices.Contains(domain.FieldFormats, property.Format) {
+			add("format=" + property.Format)
+		}
+		if property.Pattern != "" {
+			add("pattern=" + property.Pattern)
+		}
+		if property.Default.Kind == yaml.ScalarNode {
+			def := property.Default.Value
+			if goType == "string" {
+				def = "'" + strings.ReplaceAll(def, "'", "''") + "'"
+			}
+			if goType != "time" {
+				add("default=" + def)
+			}
+		}
+		if p.Name != column {
+			add("json=" + p.Name)
+		}
+		fields = append(fields, field.String())
+	}
+	return fields, uuid, warnings
+}
+
+// openAPIType returns the -fields type of a schema type and format, if any.
+func openAPIType(typ, format string) string {
+	switch typ {
+	case "string":
+		if format == "date-time" || format == "date" {
+			return "time"
+		}
+		return "string"
+	case "integer":
+		if format == "int32" {
+			return "int32"
+		}
+		return "int64"
+	case "number":
+		if format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "boolean":
+		return "bool"
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+func TestParseOpenAPI(t *testing.T) {
+	spec := `openapi: 3.1.0
+paths:
+  /api/v1/pets:
+    get:
+      responses:
+        "200":
+          content:
+            application/json:
+              schema: {type: array, items: {$ref: "#/components/schemas/Pet"}}
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: "#/components/schemas/Pet"}
+  /api/v1/pets/{petId}:
+    get: {}
+    delete: {}
+    patch:
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: "#/components/schemas/Pet"}
+  /api/v1/pets/{petId}/adopt:
+    post:
+      operationId: adoptPet
+      summary: Adopt a pet.
+      requestBody:
+        content:
+          application/json:
+            schema: {type: object}
+  /api/v1/pets/search:
+    get: {}
+  /api/v1/pets/{petId}/photos/{photoId}:
+    get: {}
+  /api/v1/health:
+    get: {}
+components:
+  schemas:
+    Pet:
+      allOf:
+        - $ref: "#/components/schemas/Entity"
+        - required: [name]
+          properties:
+            name: {type: string, minLength: 1, maxLength: 64}
+            status: {type: string, enum: [available, sold], default: available}
+            ownerEmail: {type: [string, "null"], format: email}
+            age: {type: integer, format: int32, minimum: 0}
+            tags: {type: array, items: {type: string}}
+            code: {type: string, pattern: "^[A-Z]{2}:[0-9]+$"}
+    Entity:
+      properties:
+        id: {type: string, format: uuid}
+        created_at: {type: string, format: date-time}
+`
+	specs, warnings, err := NewImportService().ParseOpenAPI([]byte(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.FeatureSpec{{
+		Feature: "Pet",
+		Table:   "pets",
+		UUID:    true,
+		Fields:  "name:string:required:min=1:max=64,status:string:enum=available|sold:default='available',owner_email:string:null:format=email:json=ownerEmail,age:int32:min=0,code:string",
+		Operations: []domain.Operation{
+			{Name: "PatchPet", Method: "PATCH", Path: "/{id}", Body: "domain"},
+			{Name: "AdoptPet", Method: "POST", Path: "/{id}/adopt", Summary: "Adopt a pet.", Body: "map"},
+			{Name: "SearchPets", Method: "GET", Path: "/search"},
+		},
+	}}
+	if !reflect.DeepEqual(specs, want) {
+		t.Errorf("got specs %+v, want %+v", specs, want)
+	}
+	for _, spec := range specs {
+		if _, err := ParseFields(spec.Fields); err != nil {
+			t.Errorf("feature %s: %v", spec.Feature, err)
+		}
+	}
+	if len(warnings) != 4 {
+		t.Errorf("got warnings %q, want the tags and code properties, the photo path and the health resource", warnings)
+	}
+
+	if _, _, err := NewImportService().ParseOpenAPI([]byte("swagger: \"2.0\"\n")); err == nil {
+		t.Error("ParseOpenAPI of Swagger 2 succeeded, want an error")
+	}
+}
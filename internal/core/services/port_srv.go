@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,6 +9,20 @@ import (
 	"github.com/rapidstellar/gohexa/internal/core/domain"
 )
 
+// portTemplate returns the template key, source and data of the ports file.
+func (g *GeneratorServiceImpls) portTemplate() (string, string, any) {
+	data := domain.PortFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		Operations:  g.flag.Operations,
+	}
+	if g.flag.PureDomain {
+		return "port.pure", domain.PurePortsTemplate, data
+	}
+	return "port", domain.PortsTemplate, data
+}
+
 // GeneratePortsFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GeneratePortsFile(dir string) {
 	// Default to current directory if not provided
@@ -20,17 +33,10 @@ func (g *GeneratorServiceImpls) GeneratePortsFile(dir string) {
 			return
 		}
 	}
-	// Prepare the data for template rendering
-	data := domain.PortFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-		IDType:      "uint", // Default to uint, can be changed to string if UUID is used
-	}
 
-	// Parse and execute the template
-	tmpl, err := template.New("ports").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.PortsTemplate)
+	// Render the template
+	templateKey, templateText, data := g.portTemplate()
+	content, err := renderTemplate("ports", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -40,19 +46,11 @@ func (g *GeneratorServiceImpls) GeneratePortsFile(dir string) {
 	fileName := fmt.Sprintf("%s_ports.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Ports file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Ports file '%s' created successfully!\n", filePath)
+	g.recordLayer("port", templateKey, filePath)
 }
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// templateFuncs are the helpers available to every template.
+var templateFuncs = template.FuncMap{
+	"ToLower":   strings.ToLower,
+	"Pluralize": utils.Pluralize,
+	"ProtoGoType": func(goType string) string {
+		return domain.ProtoGoTypes[domain.ProtoTypes[goType]]
+	},
+	"ToLowerCamel": utils.ToLowerCamel,
+	"GraphQLGoType": func(graphqlType string) string {
+		return domain.GraphQLGoTypes[graphqlType]
+	},
+}
+
+// renderTemplate executes a template with data. Generated Go code is not HTML,
+// so text/template is used to keep quotes in struct tags intact.
+func renderTemplate(name, text string, data any) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
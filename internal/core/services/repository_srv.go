@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +10,31 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// repositoryTemplate returns the template key, source and data of the repository file.
+func (g *GeneratorServiceImpls) repositoryTemplate() (string, string, any) {
+	data := domain.RepositoryFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		Fields:      g.fields(),
+		PureDomain:  g.flag.PureDomain,
+		UseUUID:     g.flag.UseUUID,
+		NoReturning: g.dialect().NoReturning,
+	}
+	key := "repository"
+	if g.flag.PureDomain {
+		key = "repository.pure"
+	}
+	key, text := g.ormTemplate(key)
+	switch g.persistence() {
+	case "sqlc":
+		return key, text, g.sqlcData()
+	case "ent":
+		return key, text, g.entData()
+	}
+	return key, text, data
+}
+
 // GenerateRepoFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateRepoFile(dir string) {
 	// Define the template for the repository file
@@ -19,16 +43,10 @@ func (g *GeneratorServiceImpls) GenerateRepoFile(dir string) {
 	if err != nil {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
-	// Prepare the data for template rendering
-	data := domain.RepositoryFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-	}
 
-	// Parse and execute the template
-	tmpl, err := template.New("repo").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.RepoTemplate)
+	// Render the template
+	templateKey, templateText, data := g.repositoryTemplate()
+	content, err := renderTemplate("repo", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -38,19 +56,18 @@ func (g *GeneratorServiceImpls) GenerateRepoFile(dir string) {
 	fileName := fmt.Sprintf("%s_repository.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		fmt.Printf("Error writing to file: %v\n", err)
 		return
 	}
-	defer file.Close()
+	fmt.Printf("Repository file '%s' created successfully!\n", filePath)
+	g.recordLayer("repository", templateKey, filePath)
 
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
-		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Repository file '%s' created successfully!\n", filePath)
+	if key, _, _ := g.sqlcQueriesTemplate(); key != "" {
+		g.generateSqlcFiles()
+	}
+	if key, _, _ := g.entSchemaTemplate(); key != "" {
+		g.generateEntFiles()
 	}
 }
@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -11,6 +10,33 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// routeTemplate returns the template key, source and data of the route file.
+func (g *GeneratorServiceImpls) routeTemplate() (string, string, any) {
+	data := domain.RouteFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		Path:        g.routePath(),
+		Operations:  g.flag.Operations,
+	}
+	key, text := g.httpTemplate("route")
+	return key, text, data
+}
+
+// routePath returns the path of the routes of the feature, by default its lower-case plural.
+func (g *GeneratorServiceImpls) routePath() string {
+	if g.flag.Path != "" {
+		return strings.Trim(g.flag.Path, "/")
+	}
+	return strings.ToLower(utils.Pluralize(g.flag.FeatureName))
+}
+
+// routerTemplate returns the template key, source and data of the RouterImpl the route files of
+// the selected framework extend. The key is empty for Fiber, whose RouterImpl ships with the project.
+func (g *GeneratorServiceImpls) routerTemplate() (string, string, any) {
+	key, text := g.httpTemplate("router")
+	return key, text, nil
+}
+
 // GenerateRouteFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateRouteFile(dir string) {
 	defaultDir := "./internal/adapters/http/routers"
@@ -18,22 +44,10 @@ func (g *GeneratorServiceImpls) GenerateRouteFile(dir string) {
 	if err != nil {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
-	// Prepare the data for template rendering
-	data := domain.RouteFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-	}
 
-	// Parse and execute the template
-	tmpl, err := template.New("route").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-		"Pluralize": func(s string) string {
-			if strings.HasSuffix(s, "s") {
-				return s
-			}
-			return s + "s"
-		},
-	}).Parse(domain.RouteTemplate)
+	// Render the template
+	templateKey, templateText, data := g.routeTemplate()
+	content, err := renderTemplate("route", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -43,19 +57,35 @@ func (g *GeneratorServiceImpls) GenerateRouteFile(dir string) {
 	fileName := fmt.Sprintf("%s_routes.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		fmt.Printf("Error writing to file: %v\n", err)
 		return
 	}
-	defer file.Close()
+	fmt.Printf("Route file '%s' created successfully!\n", filePath)
+	g.recordLayer("route", templateKey, filePath)
+	g.generateRouterFile(dir)
+}
 
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
+// generateRouterFile writes the RouterImpl of the selected framework next to the route files,
+// unless the framework has none or it already exists.
+func (g *GeneratorServiceImpls) generateRouterFile(dir string) {
+	templateKey, templateText, data := g.routerTemplate()
+	filePath := filepath.Join(dir, "router.go")
+	if templateKey == "" {
+		return
+	}
+	if _, err := os.Stat(filePath); err == nil {
+		return
+	}
+	content, err := renderTemplate("router", templateText, data)
 	if err != nil {
+		fmt.Printf("Error parsing template: %v\n", err)
+		return
+	}
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Route file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Router file '%s' created successfully!\n", filePath)
 }
@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,6 +9,20 @@ import (
 	"github.com/rapidstellar/gohexa/internal/core/domain"
 )
 
+// serviceTemplate returns the template key, source and data of the service file.
+func (g *GeneratorServiceImpls) serviceTemplate() (string, string, any) {
+	data := domain.ServiceFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		Operations:  g.flag.Operations,
+	}
+	if g.flag.PureDomain {
+		return "service.pure", domain.PureServiceTemplate, data
+	}
+	return "service", domain.ServiceTemplate, data
+}
+
 // GenerateServiceFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateServiceFile(dir string) {
 	// Define the template for the service file
@@ -21,16 +34,9 @@ func (g *GeneratorServiceImpls) GenerateServiceFile(dir string) {
 		}
 	}
 
-	// Prepare the data for template rendering
-	data := domain.ServiceFlagDomain{
-		FeatureName: g.flag.FeatureName,
-		ProjectName: g.flag.ProjectName,
-	}
-
-	// Parse and execute the template
-	tmpl, err := template.New("service").Funcs(template.FuncMap{
-		"ToLower": strings.ToLower,
-	}).Parse(domain.ServiceTemplate)
+	// Render the template
+	templateKey, templateText, data := g.serviceTemplate()
+	content, err := renderTemplate("service", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
@@ -40,19 +46,11 @@ func (g *GeneratorServiceImpls) GenerateServiceFile(dir string) {
 	fileName := fmt.Sprintf("%s_service.go", strings.ToLower(g.flag.FeatureName))
 	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Execute the template and write to the file
-	err = tmpl.Execute(file, data)
-	if err != nil {
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Service file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Service file '%s' created successfully!\n", filePath)
+	g.recordLayer("service", templateKey, filePath)
 }
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// sqlcData returns the data of the sqlc templates.
+func (g *GeneratorServiceImpls) sqlcData() domain.SqlcFlagDomain {
+	var fields []domain.SqlcField
+	for _, f := range g.fields() {
+		sqlType := domain.PostgresTypes[f.Type]
+		field := domain.SqlcField{
+			Name:     f.Name,
+			Column:   f.Column,
+			SQLType:  sqlType,
+			GoName:   utils.ToSqlcName(f.Column),
+			GoType:   domain.SqlcGoTypes[sqlType],
+			ToSqlc:   "data." + f.Name,
+			FromSqlc: "row." + utils.ToSqlcName(f.Column),
+		}
+		switch {
+		case f.Nullable:
+			nullableSqlcField(&field, f, utils.ToLowerCamel(g.flag.FeatureName))
+		case f.Type != field.GoType:
+			field.ToSqlc = field.GoType + "(" + field.ToSqlc + ")"
+			field.FromSqlc = f.Type + "(" + field.FromSqlc + ")"
+		}
+		fields = append(fields, field)
+	}
+	table := g.table()
+	idGoType := domain.SqlcGoTypes["BIGSERIAL"]
+	if g.flag.UseUUID {
+		idGoType = domain.SqlcGoTypes["UUID"]
+	}
+	return domain.SqlcFlagDomain{
+		FeatureName: g.flag.FeatureName,
+		ProjectName: g.flag.ProjectName,
+		IDType:      g.idType(),
+		UseUUID:     g.flag.UseUUID,
+		PureDomain:  g.flag.PureDomain,
+		Table:       table,
+		RowType:     utils.ToSqlcName(table),
+		IDGoType:    idGoType,
+		Fields:      fields,
+		Nullable:    g.nullable(),
+		Dialect:     g.dialect(),
+	}
+}
+
+// nullableSqlcField sets the sql.Null type of a nullable column and the conversions of its field,
+// whose pointer is nil for NULL, with the "nullable" helpers prefixed with prefix.
+func nullableSqlcField(field *domain.SqlcField, f domain.Field, prefix string) {
+	field.Nullable = true
+	field.GoType = domain.SqlcNullGoTypes[field.SQLType]
+	value := strings.TrimPrefix(field.GoType, "sql.Null")
+	valueType := domain.SqlcGoTypes[field.SQLType]
+	if field.SQLType == "REAL" {
+		valueType = "float64"
+	}
+	data, row := "data."+f.Name, field.FromSqlc
+	set := prefix + "Value(" + data + ")"
+	get := row + "." + value
+	if f.Type != valueType {
+		set = valueType + "(" + set + ")"
+		get = f.Type + "(" + get + ")"
+	}
+	field.ToSqlc = field.GoType + "{" + value + ": " + set + ", Valid: " + data + " != nil}"
+	field.FromSqlc = prefix + "Pointer(" + get + ", " + row + ".Valid)"
+}
+
+// sqlcQueriesTemplate and sqlcSchemaTemplate return the template key, source and data of the
+// annotated queries and the table DDL. The key is empty unless -orm sqlc is selected.
+func (g *GeneratorServiceImpls) sqlcQueriesTemplate() (string, string, any) {
+	key, text := g.ormTemplate("queries")
+	return key, text, g.sqlcData()
+}
+
+func (g *GeneratorServiceImpls) sqlcSchemaTemplate() (string, string, any) {
+	key, text := g.ormTemplate("schema")
+	return key, text, g.sqlcData()
+}
+
+// sqlcGenTemplate returns what sqlc generates from the queries, declarations only. It is only
+// used to verify the repository.
+func (g *GeneratorServiceImpls) sqlcGenTemplate() (string, string, any) {
+	if g.persistence() != "sqlc" {
+		return "", "", nil
+	}
+	return "sqlc.gen", domain.SqlcStub, g.sqlcData()
+}
+
+// generateSqlcFiles writes the queries and the schema of the feature that sqlc generates the
+// repository's Queries from, and sqlc.yaml when it is missing. Paths are relative to the project root.
+func (g *GeneratorServiceImpls) generateSqlcFiles() {
+	const configPath = "sqlc.yaml"
+	if _, err := os.Stat(configPath); errors.Is(err, os.ErrNotExist) {
+		if err := os.WriteFile(configPath, []byte(domain.SqlcConfigTemplate), 0644); err != nil {
+			fmt.Printf("Error writing to file: %v\n", err)
+			return
+		}
+		fmt.Printf("sqlc file '%s' created successfully!\n", configPath)
+	}
+
+	lower := strings.ToLower(g.flag.FeatureName)
+	files := []struct {
+		layer, dir string
+		render     func() (string, string, any)
+	}{
+		{"queries", filepath.Join("internal", "adapters", "database", "queries"), g.sqlcQueriesTemplate},
+		{"schema", filepath.Join("internal", "adapters", "database", "schema"), g.sqlcSchemaTemplate},
+	}
+	for _, f := range files {
+		if err := os.MkdirAll(f.dir, os.ModePerm); err != nil {
+			fmt.Printf("Error creating directories: %v\n", err)
+			return
+		}
+
+		// Render the template
+		templateKey, templateText, data := f.render()
+		content, err := renderTemplate(templateKey, templateText, data)
+		if err != nil {
+			fmt.Printf("Error parsing template: %v\n", err)
+			return
+		}
+
+		// Write the output file
+		filePath := filepath.Join(f.dir, lower+".sql")
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			fmt.Printf("Error writing to file: %v\n", err)
+			return
+		}
+		fmt.Printf("sqlc file '%s' created successfully!\n", filePath)
+		g.recordLayer(f.layer, templateKey, filePath)
+	}
+	fmt.Println("Generate the Queries of the repository with: sqlc generate")
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// structTypes maps the qualified types of struct fields to the -fields types, and whether they are
+// nullable. The builtin types of domain.FieldTypes map to themselves.
+var structTypes = map[string]struct {
+	typ      string
+	nullable bool
+}{
+	"time.Time":       {"time", false},
+	"uuid.UUID":       {"string", false},
+	"sql.NullString":  {"string", true},
+	"sql.NullInt32":   {"int32", true},
+	"sql.NullInt64":   {"int64", true},
+	"sql.NullFloat64": {"float64", true},
+	"sql.NullBool":    {"bool", true},
+	"sql.NullTime":    {"time", true},
+}
+
+// ParseStruct implements ports.IImportService. The feature is named after the struct, without a
+// Domain suffix, and its table is the one of a TableName method of the struct, if any. Its fields
+// are the exported fields of the struct besides ID, CreatedAt, UpdatedAt and DeletedAt, with the
+// JSON names of their json tags, the indexes and defaults of their gorm tags and the constraints of
+// their validate or binding tags. Fields of other types are skipped with a warning.
+func (s *ImportServiceImpls) ParseStruct(filename string, src []byte, typeName string) (domain.FeatureSpec, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return domain.FeatureSpec{}, nil, err
+	}
+	var st *ast.StructType
+	table := ""
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == typeName {
+					if st, ok = ts.Type.(*ast.StructType); !ok {
+						return domain.FeatureSpec{}, nil, fmt.Errorf("%s is not a struct", typeName)
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if name, ok := tableName(d, typeName); ok {
+				table = name
+			}
+		}
+	}
+	if st == nil {
+		return domain.FeatureSpec{}, nil, fmt.Errorf("no struct %s in %s", typeName, filename)
+	}
+
+	feature := strings.TrimSuffix(typeName, "Domain")
+	if feature == "" {
+		feature = typeName
+	}
+	if table == "" {
+		table = strings.ToLower(feature) + "s"
+	}
+	spec := domain.FeatureSpec{Feature: feature, Table: table}
+	var fields, warnings []string
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			if embedded := types.ExprString(f.Type); embedded != "gorm.Model" {
+				warnings = append(warnings, fmt.Sprintf("skipping embedded field %s.%s: its fields are not imported", typeName, embedded))
+			}
+			continue
+		}
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			unquoted, _ := strconv.Unquote(f.Tag.Value)
+			tag = reflect.StructTag(unquoted)
+		}
+		for _, name := range f.Names {
+			if !name.IsExported() {
+				continue
+			}
+			where := typeName + "." + name.Name
+			typ, nullable := structType(f.Type)
+			column := utils.ToSnake(name.Name)
+			if column == "id" {
+				spec.UUID = typ == "string"
+				continue
+			}
+			if generatedColumns[column] {
+				continue
+			}
+			if typ == "" {
+				warnings = append(warnings, fmt.Sprintf("skipping field %s: unsupported type %s", where, types.ExprString(f.Type)))
+				continue
+			}
+			if tagged := tagColumn(tag); tagged != "" && tagged != column {
+				warnings = append(warnings, fmt.Sprintf("field %s: the column is %s rather than %s, as the generated layers name it after the field", where, column, tagged))
+			}
+			field := newImportedField(column, typ, "field "+where, &warnings)
+			structOptions(field, tag, nullable)
+			if json, _, _ := strings.Cut(tag.Get("json"), ","); json != "" && json != "-" && json != column {
+				field.add("json=" + json)
+			}
+			fields = append(fields, field.String())
+		}
+	}
+	if len(fields) == 0 {
+		return domain.FeatureSpec{}, warnings, fmt.Errorf("struct %s has no fields besides the generated ones", typeName)
+	}
+	spec.Fields = strings.Join(fields, ",")
+	return spec, warnings, nil
+}
+
+// structType returns the -fields type of the type of a struct field, if any, and whether it is
+// nullable, as a pointer or a sql.Null type is.
+func structType(expr ast.Expr) (string, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		typ, _ := structType(star.X)
+		return typ, true
+	}
+	name := types.ExprString(expr)
+	if t, ok := structTypes[name]; ok {
+		return t.typ, t.nullable
+	}
+	if _, ok := expr.(*ast.Ident); ok && domain.FieldTypes[name] == name {
+		return name, false
+	}
+	return "", false
+}
+
+// structOptions adds the options of a field from its gorm tag, then from its validate or binding
+// tag.
+func structOptions(field *importedField, tag reflect.StructTag, nullable bool) {
+	for _, setting := range strings.Split(tag.Get("gorm"), ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(setting), ":")
+		switch strings.ToLower(key) {
+		case "index":
+			field.add("index")
+		case "uniqueindex", "unique":
+			field.add("unique")
+		case "default":
+			field.add("default=" + value)
+		}
+	}
+	if nullable {
+		field.add("null")
+	}
+	rules := tag.Get("validate")
+	if rules == "" {
+		rules = tag.Get("binding")
+	}
+	for _, rule := range strings.Split(rules, ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			if field.typ == "string" || field.typ == "time" {
+				field.add("required")
+			}
+		case "min", "gte":
+			field.add("min=" + value)
+		case "max", "lte":
+			field.add("max=" + value)
+		case "len":
+			field.add("min=" + value)
+			field.add("max=" + value)
+		case "oneof":
+			field.add("enum=" + strings.Join(strings.Fields(value), "|"))
+		case "email":
+			field.add("format=email")
+		case "uuid", "uuid4":
+			field.add("format=uuid")
+		case "url", "uri":
+			field.add("format=uri")
+		}
+	}
+}
+
+// tagColumn returns the column a db, gorm or bun tag names, if any.
+func tagColumn(tag reflect.StructTag) string {
+	for _, setting := range strings.Split(tag.Get("gorm"), ";") {
+		if column, ok := strings.CutPrefix(strings.TrimSpace(setting), "column:"); ok {
+			return column
+		}
+	}
+	for _, key := range []string{"db", "bun"} {
+		if column, _, _ := strings.Cut(tag.Get(key), ","); column != "" && column != "-" {
+			return column
+		}
+	}
+	return ""
+}
+
+// tableName returns the table a TableName method of the struct returns, e.g. func (Order)
+// TableName() string { return "sales_orders" }.
+func tableName(fn *ast.FuncDecl, typeName string) (string, bool) {
+	if fn.Name.Name != "TableName" || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil || len(fn.Body.List) != 1 {
+		return "", false
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if ident, ok := recv.(*ast.Ident); !ok || ident.Name != typeName {
+		return "", false
+	}
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	name, err := strconv.Unquote(lit.Value)
+	return name, err == nil
+}
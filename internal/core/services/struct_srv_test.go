@@ -0,0 +1,52 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+func TestParseStruct(t *testing.T) {
+	src := `package domain
+
+type OrderDomain struct {
+	gorm.Model
+	ID         uuid.UUID ` + "`gorm:\"type:uuid;primaryKey\"`" + `
+	CustomerID uint      ` + "`json:\"customerId\" gorm:\"index\"`" + `
+	Reference  string    ` + "`gorm:\"uniqueIndex\" validate:\"required,max=32\"`" + `
+	Status     string    ` + "`gorm:\"default:'new'\" binding:\"oneof=new paid\"`" + `
+	PaidAt     *time.Time
+	Note       sql.NullString ` + "`db:\"remark\"`" + `
+	Items      []string
+	secret     string
+}
+
+func (*OrderDomain) TableName() string { return "sales_orders" }
+`
+	spec, warnings, err := NewImportService().ParseStruct("order.go", []byte(src), "OrderDomain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := domain.FeatureSpec{
+		Feature: "Order",
+		Table:   "sales_orders",
+		UUID:    true,
+		Fields:  "customer_id:uint:index:json=customerId,reference:string:unique:required:max=32,status:string:default='new':enum=new|paid,paid_at:time:null,note:string:null",
+	}
+	if !reflect.DeepEqual(spec, want) {
+		t.Errorf("got spec %+v, want %+v", spec, want)
+	}
+	if _, err := ParseFields(spec.Fields); err != nil {
+		t.Error(err)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("got warnings %q, want the remark column and the Items field", warnings)
+	}
+
+	for _, typeName := range []string{"Order", "TableName"} {
+		if _, _, err := NewImportService().ParseStruct("order.go", []byte(src), typeName); err == nil {
+			t.Errorf("ParseStruct of %s succeeded, want an error", typeName)
+		}
+	}
+}
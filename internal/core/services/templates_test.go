@@ -0,0 +1,360 @@
+package services
+
+import (
+	"bytes"
+	"flag"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/configs"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// templateCases is the input matrix every template is rendered with.
+var templateCases = []struct {
+	name    string
+	flag    domain.GeneratorFlagDomain
+	useUUID bool
+}{
+	{name: "uint", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project"}},
+	{name: "uuid", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true}, useUUID: true},
+	{name: "multi_word", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project"}},
+	{name: "fields", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id"},
+		{Name: "Total", Type: "float64", Column: "total"},
+		{Name: "Paid", Type: "bool", Column: "paid"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}},
+	{name: "pure", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, PureDomain: true}, useUUID: true},
+	{name: "gin", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", HTTP: "gin"}},
+	{name: "gin_uuid", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, HTTP: "gin"}, useUUID: true},
+	{name: "echo", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", HTTP: "echo"}},
+	{name: "stdlib", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, HTTP: "stdlib"}, useUUID: true},
+	{name: "chi", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", HTTP: "chi"}},
+	{name: "connect", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", UseUUID: true, RPC: "connect", Fields: []domain.Field{
+		{Name: "Total", Type: "float64", Column: "total"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}, useUUID: true},
+	{name: "sqlx", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", ORM: "sqlx"}},
+	{name: "sqlx_pure", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "sqlx", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}, useUUID: true},
+	{name: "pgx", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", ORM: "pgx", Fields: []domain.Field{
+		{Name: "Total", Type: "float64", Column: "total"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}},
+	{name: "pgx_pure", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "pgx"}, useUUID: true},
+	{name: "sqlc", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", ORM: "sqlc", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id"},
+		{Name: "Total", Type: "float64", Column: "total"},
+		{Name: "Paid", Type: "bool", Column: "paid"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}},
+	{name: "sqlc_uuid", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "sqlc"}, useUUID: true},
+	{name: "ent", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", ORM: "ent", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id"},
+		{Name: "Total", Type: "float64", Column: "total"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}},
+	{name: "ent_pure", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "ent"}, useUUID: true},
+	{name: "bun", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", ORM: "bun", Fields: []domain.Field{
+		{Name: "Total", Type: "float64", Column: "total"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}},
+	{name: "bun_pure", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "bun"}, useUUID: true},
+	{name: "mongo", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, DB: "mongo", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id"},
+		{Name: "DueAt", Type: "time.Time", Column: "due_at"},
+	}}, useUUID: true},
+	{name: "mongo_pure", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", PureDomain: true, DB: "mongo"}},
+	{name: "mysql_uuid", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, DB: "mysql"}, useUUID: true},
+	{name: "sqlx_mysql_uuid", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "sqlx", DB: "mysql"}, useUUID: true},
+	{name: "sqlite_bun", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "bun", DB: "sqlite"}, useUUID: true},
+	{name: "migration_mysql", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", ORM: "sqlx", DB: "mysql", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id", Index: "index", References: "customers"},
+		{Name: "Reference", Type: "string", Column: "reference", Index: "unique"},
+		{Name: "Total", Type: "float64", Column: "total", Default: "0"},
+		{Name: "Note", Type: "string", Column: "note", Nullable: true},
+	}}},
+	{name: "migration_goose", flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", UseUUID: true, DB: "sqlite", Migration: "goose", Fields: []domain.Field{
+		{Name: "CustomerID", Type: "string", Column: "customer_id", References: "customers"},
+		{Name: "PaidAt", Type: "time.Time", Column: "paid_at", Index: "index"},
+	}}, useUUID: true},
+	{name: "nullable", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", Fields: []domain.Field{
+		{Name: "Note", Type: "string", Column: "note", Nullable: true, Max: "200"},
+		{Name: "Quantity", Type: "uint", Column: "quantity", Nullable: true, Min: "1"},
+		{Name: "PaidAt", Type: "time.Time", Column: "paid_at", Nullable: true, Required: true},
+		{Name: "Total", Type: "float64", Column: "total"},
+	}}},
+	{name: "nullable_sqlc", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", PureDomain: true, ORM: "sqlc", RPC: "connect", Fields: []domain.Field{
+		{Name: "Note", Type: "string", Column: "note", Nullable: true},
+		{Name: "Quantity", Type: "uint", Column: "quantity", Nullable: true},
+		{Name: "Score", Type: "float32", Column: "score", Nullable: true},
+		{Name: "PaidAt", Type: "time.Time", Column: "paid_at", Nullable: true},
+	}}},
+	{name: "nullable_ent", flag: domain.GeneratorFlagDomain{FeatureName: "Invoice", ProjectName: "my_project", ORM: "ent", Fields: []domain.Field{
+		{Name: "Note", Type: "string", Column: "note", Nullable: true},
+		{Name: "Total", Type: "float64", Column: "total"},
+	}}},
+	{name: "cockroachdb_sqlc", flag: domain.GeneratorFlagDomain{FeatureName: "SeaPort", ProjectName: "my_project", UseUUID: true, PureDomain: true, ORM: "sqlc", DB: "cockroachdb"}, useUUID: true},
+	{name: "openapi", flag: domain.GeneratorFlagDomain{FeatureName: "Pet", ProjectName: "my_project", Path: "pets", Fields: []domain.Field{
+		{Name: "Name", Type: "string", Column: "name", Required: true, Min: "1", Max: "64"},
+		{Name: "Status", Type: "string", Column: "status", Enum: "available|sold"},
+		{Name: "OwnerEmail", Type: "string", Column: "owner_email", JSON: "ownerEmail", Format: "email"},
+		{Name: "Tag", Type: "string", Column: "tag", Pattern: "^[a-z]+$"},
+		{Name: "Age", Type: "int32", Column: "age", Min: "0"},
+	}, Operations: []domain.Operation{
+		{Name: "AdoptPet", Method: "POST", Path: "/{id}/adopt", Summary: "Adopt a pet.", Body: "map"},
+		{Name: "SearchPets", Method: "GET", Path: "/search"},
+		{Name: "ReplacePet", Method: "PATCH", Path: "/{id}/replace", Body: "domain"},
+	}}},
+	{name: "openapi_chi", flag: domain.GeneratorFlagDomain{FeatureName: "Pet", ProjectName: "my_project", UseUUID: true, HTTP: "chi", Fields: []domain.Field{
+		{Name: "Name", Type: "string", Column: "name", Required: true, Max: "64"},
+	}, Operations: []domain.Operation{
+		{Name: "AdoptPet", Method: "POST", Path: "/{id}/adopt", Body: "domain"},
+		{Name: "SearchPets", Method: "GET", Path: "/search"},
+	}}, useUUID: true},
+}
+
+// layerTemplates returns the renderers of all templates, keyed by golden file name.
+func layerTemplates(g *GeneratorServiceImpls, useUUID bool) map[string]func() (string, string, any) {
+	return map[string]func() (string, string, any){
+		"app.go":              g.appTemplate,
+		"domain.go":           func() (string, string, any) { return g.domainTemplate(useUUID) },
+		"ent_schema.go":       g.entSchemaTemplate,
+		"graphql.graphqls":    g.graphqlSchemaTemplate,
+		"grpc.go":             g.grpcServerTemplate,
+		"grpc_app.go":         g.grpcAppTemplate,
+		"grpc_container.go":   g.grpcContainerTemplate,
+		"handler.go":          g.handlerTemplate,
+		"migration.sql":       g.migrationTemplate,
+		"migration_down.sql":  g.migrationDownTemplate,
+		"model.go":            func() (string, string, any) { return g.modelTemplate(useUUID) },
+		"port.go":             g.portTemplate,
+		"proto.proto":         g.protoTemplate,
+		"repository.go":       g.repositoryTemplate,
+		"resolver.go":         g.graphqlResolverTemplate,
+		"resolver_convert.go": g.graphqlConvertTemplate,
+		"route.go":            g.routeTemplate,
+		"router.go":           g.routerTemplate,
+		"service.go":          g.serviceTemplate,
+		"sqlc_queries.sql":    g.sqlcQueriesTemplate,
+		"sqlc_schema.sql":     g.sqlcSchemaTemplate,
+		"transactor.go":       g.transactorTemplate,
+		"validation.go":       g.validationTemplate,
+	}
+}
+
+func TestTemplatesGolden(t *testing.T) {
+	for _, tc := range templateCases {
+		g := &GeneratorServiceImpls{flag: tc.flag}
+		for file, render := range layerTemplates(g, tc.useUUID) {
+			t.Run(tc.name+"/"+file, func(t *testing.T) {
+				key, text, data := render()
+				if key == "" {
+					t.Skip("no such template for this case")
+				}
+				got, err := renderTemplate(key, text, data)
+				if err != nil {
+					t.Fatalf("render %s: %v", key, err)
+				}
+				if filepath.Ext(file) == ".go" {
+					if _, err := parser.ParseFile(token.NewFileSet(), file, got, parser.AllErrors); err != nil {
+						t.Errorf("%s is not valid Go: %v", key, err)
+					}
+				}
+
+				checkGolden(t, key, filepath.Join("testdata", "golden", tc.name, file+".golden"), got)
+			})
+		}
+	}
+}
+
+// checkGolden compares the rendering of the template key with the golden file, which -update
+// rewrites.
+func checkGolden(t *testing.T, key, golden string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("missing golden file, run go test -update: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from %s, run go test -update if the change is intended\n--- got ---\n%s", key, golden, got)
+	}
+}
+
+// TestMigrationAlterGolden renders the migrations of fields changed since a recorded schema: total
+// renamed to amount, retyped and made NOT NULL, customer_id without its index and foreign key,
+// status made nullable without its default, note and warehouse_id dropped, and paid_at, which is
+// nullable, and reference added.
+func TestMigrationAlterGolden(t *testing.T) {
+	previous := []domain.ManifestColumn{
+		{Column: "customer_id", Type: "uint", Index: "index", References: "customers"},
+		{Column: "total", Type: "float32", Index: "index", Nullable: true},
+		{Column: "status", Type: "string", Default: "'new'"},
+		{Column: "note", Type: "string"},
+		{Column: "warehouse_id", Type: "uint", References: "warehouses"},
+	}
+	fields := []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id"},
+		{Name: "Amount", Type: "float64", Column: "amount", Index: "index", Renamed: "total"},
+		{Name: "Status", Type: "string", Column: "status", Nullable: true},
+		{Name: "PaidAt", Type: "time.Time", Column: "paid_at", Nullable: true},
+		{Name: "Reference", Type: "string", Column: "reference", Index: "unique", References: "invoices"},
+	}
+	for _, db := range []string{"postgres", "mysql", "sqlite"} {
+		for _, migration := range domain.MigrationFormats {
+			g := &GeneratorServiceImpls{flag: domain.GeneratorFlagDomain{FeatureName: "Order", ORM: "sqlx", DB: db, Migration: migration, Fields: fields}}
+			for _, key := range []string{"migration", "migration_down"} {
+				key, text := variantTemplate(key, migration, domain.MigrationFormats)
+				if key == "" {
+					continue
+				}
+				t.Run(db+"/"+key, func(t *testing.T) {
+					got, err := renderTemplate(key, text, g.migrationData(previous))
+					if err != nil {
+						t.Fatalf("render %s: %v", key, err)
+					}
+					checkGolden(t, key, filepath.Join("testdata", "golden", "migration_alter_"+db, key+".sql.golden"), got)
+				})
+			}
+		}
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields("customer_id:uint:index:ref=customers, totalAmount:decimal:default=0,paid_at:timestamp:null,email:string:required:max=120:format=email:json=mail,status:string:enum=new|paid,age:int32:min=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []domain.Field{
+		{Name: "CustomerID", Type: "uint", Column: "customer_id", Index: "index", References: "customers"},
+		{Name: "TotalAmount", Type: "float64", Column: "total_amount", Default: "0"},
+		{Name: "PaidAt", Type: "time.Time", Column: "paid_at", Nullable: true},
+		{Name: "Email", Type: "string", Column: "email", JSON: "mail", Required: true, Max: "120", Format: "email"},
+		{Name: "Status", Type: "string", Column: "status", Enum: "new|paid"},
+		{Name: "Age", Type: "int32", Column: "age", Min: "0"},
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("got %d fields, want %d", len(fields), len(want))
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("field %d = %+v, want %+v", i, fields[i], want[i])
+		}
+	}
+
+	for _, spec := range []string{"name", "name:money", "id:uint", "a:string,a:int", "a:string:primary", "a:uint:ref=", "a:int:default=",
+		"a:bool:min=1", "a:string:max=1.5", "a:uint:min=-1", "a:int:enum=a|b", "a:string:format=ipv4", "a:string:pattern=(", "a:int:required"} {
+		if _, err := ParseFields(spec); err == nil {
+			t.Errorf("ParseFields(%q) succeeded, want an error", spec)
+		}
+	}
+}
+
+func TestImportFeatures(t *testing.T) {
+	tables := []domain.SchemaTable{
+		{Name: "categories", Columns: []domain.SchemaColumn{
+			{Name: "id", Type: "uuid", PrimaryKey: true},
+			{Name: "title", Type: "varchar(120)"},
+		}},
+		{Name: "order_items", Columns: []domain.SchemaColumn{
+			{Name: "id", Type: "bigint unsigned", PrimaryKey: true},
+			{Name: "created_at", Type: "datetime"},
+			{Name: "customer_id", Type: "int unsigned", References: "customers"},
+			{Name: "paid", Type: "tinyint(1)"},
+			{Name: "price", Type: "decimal(10,2)"},
+			{Name: "data", Type: "blob"},
+		}},
+		{Name: "order_tags", Columns: []domain.SchemaColumn{
+			{Name: "order_id", Type: "integer", PrimaryKey: true},
+			{Name: "tag_id", Type: "integer", PrimaryKey: true},
+		}},
+	}
+	specs, warnings := NewImportService().Features(tables)
+	want := []domain.FeatureSpec{
+		{Feature: "Category", Table: "categories", UUID: true, Fields: "title:string"},
+		{Feature: "OrderItem", Table: "order_items", Fields: "customer_id:uint32:ref=customers,paid:bool,price:float64"},
+	}
+	if len(specs) != len(want) {
+		t.Fatalf("got %d specs, want %d", len(specs), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(specs[i], want[i]) {
+			t.Errorf("spec %d = %+v, want %+v", i, specs[i], want[i])
+		}
+		if _, err := ParseFields(specs[i].Fields); err != nil {
+			t.Errorf("spec %d: %v", i, err)
+		}
+	}
+	if len(warnings) != 2 {
+		t.Errorf("got warnings %q, want the data column and the order_tags table", warnings)
+	}
+}
+
+func TestTemplatesTypeCheck(t *testing.T) {
+	for _, tc := range templateCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := &GeneratorServiceImpls{flag: tc.flag}
+			issues, err := g.VerifyFeature()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, issue := range issues {
+				t.Errorf("%s:%d:%d: %s", issue.File, issue.Line, issue.Column, issue.Message)
+			}
+		})
+	}
+}
+
+// TestProjectPersistenceEnv generates a model with a defaulted library and database, then sets the
+// environment: the manifest must not shadow it. An explicit -db is recorded and outranks it.
+func TestProjectPersistenceEnv(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	orm, database := configs.ORM, configs.DATABASE
+	t.Cleanup(func() {
+		os.Chdir(wd)
+		configs.ORM, configs.DATABASE = orm, database
+	})
+	configs.ORM, configs.DATABASE = "", ""
+
+	g := &GeneratorServiceImpls{flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", ORM: ProjectORM("."), DB: ProjectDatabase(".")}}
+	g.GenerateModelsFile("models", false)
+	configs.ORM, configs.DATABASE = "sqlx", "mysql"
+	if got := ProjectORM("."); got != "sqlx" {
+		t.Errorf("ProjectORM = %q after ORM=sqlx, want sqlx", got)
+	}
+	if got := ProjectDatabase("."); got != "mysql" {
+		t.Errorf("ProjectDatabase = %q after DATABASE=mysql, want mysql", got)
+	}
+
+	g = &GeneratorServiceImpls{flag: domain.GeneratorFlagDomain{FeatureName: "Order", ProjectName: "my_project", ORM: "bun", DB: "sqlite", ORMSet: true, DBSet: true}}
+	g.GenerateModelsFile("models", false)
+	if got := ProjectORM("."); got != "bun" {
+		t.Errorf("ProjectORM = %q after -orm bun, want bun", got)
+	}
+	if got := ProjectDatabase("."); got != "sqlite" {
+		t.Errorf("ProjectDatabase = %q after -db sqlite, want sqlite", got)
+	}
+}
@@ -0,0 +1,7 @@
+package main
+
+func AppContainer() {}
+
+func main() {
+	AppContainer()
+}
@@ -0,0 +1,13 @@
+package app
+
+import (
+	"example.com/shop/internal/adapters/http/routers"
+	domain "github.com/my_project/internal/core/domain/order"
+)
+
+var _ domain.OrderDomain
+
+func AppContainer() {
+	r := &routers.Router{}
+	r.CreateOrderRoute()
+}
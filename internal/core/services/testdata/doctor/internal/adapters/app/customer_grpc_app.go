@@ -0,0 +1,5 @@
+package app
+
+func GRPCContainer() {}
+
+func setup() {}
@@ -0,0 +1,8 @@
+package models
+
+var TNOrder = "orders"
+
+type Order struct {
+	ID    uint
+	Total float64
+}
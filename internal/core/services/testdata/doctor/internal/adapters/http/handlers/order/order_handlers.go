@@ -0,0 +1,9 @@
+package order
+
+import "github.com/gofiber/fiber/v2"
+
+type orderHandler struct{}
+
+func (h *orderHandler) ListOrders(c *fiber.Ctx) error {
+	return nil
+}
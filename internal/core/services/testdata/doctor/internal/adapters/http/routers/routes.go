@@ -0,0 +1,7 @@
+package routers
+
+type Router struct{}
+
+func (r *Router) CreateOrderRoutes() {}
+
+func (r *Router) CreateInvoiceRoutes() {}
@@ -0,0 +1,14 @@
+package order
+
+import (
+	"context"
+
+	domain "example.com/shop/internal/core/domain/order"
+)
+
+type orderRepository struct{}
+
+// GetOrder takes the uint ID of the model, not the string ID of the port.
+func (r *orderRepository) GetOrder(ctx context.Context, id uint) (*domain.OrderDomain, error) {
+	return nil, nil
+}
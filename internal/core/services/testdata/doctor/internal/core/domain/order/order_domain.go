@@ -0,0 +1,6 @@
+package order
+
+type OrderDomain struct {
+	ID    uint
+	Total float64
+}
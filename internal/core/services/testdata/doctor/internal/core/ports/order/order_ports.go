@@ -0,0 +1,21 @@
+package order
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	domain "example.com/shop/internal/core/domain/order"
+)
+
+type IOrderRepository interface {
+	GetOrder(ctx context.Context, id string) (*domain.OrderDomain, error)
+}
+
+type IOrderService interface {
+	ListOrders(ctx context.Context) ([]domain.OrderDomain, error)
+}
+
+type IOrderHandler interface {
+	ListOrders(c *fiber.Ctx) error
+}
@@ -0,0 +1,16 @@
+package order
+
+import (
+	"context"
+
+	domain "example.com/shop/internal/core/domain/order"
+	ports "example.com/shop/internal/core/ports/order"
+)
+
+type orderService struct {
+	repo ports.IOrderRepository
+}
+
+func (s *orderService) ListOrders(ctx context.Context) ([]domain.OrderDomain, error) {
+	return nil, nil
+}
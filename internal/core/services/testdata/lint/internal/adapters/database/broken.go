@@ -0,0 +1,3 @@
+package database
+
+func Broken( {
@@ -0,0 +1,10 @@
+package database
+
+import (
+	"example.com/shop/internal/core/services"
+	"example.com/shop/pkg/clock"
+)
+
+type OrderRepository struct{}
+
+var _, _ = services.NewOrderService, clock.Now
@@ -0,0 +1,8 @@
+package domain
+
+// The import path of a project generated with a -project other than the module path.
+import "github.com/example.com/shop/internal/adapters/database"
+
+type InvoiceDomain struct {
+	Repo *database.OrderRepository
+}
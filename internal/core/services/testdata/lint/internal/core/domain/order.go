@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"example.com/shop/internal/core/domain/money"
+	"example.com/shop/internal/core/ports"
+)
+
+type OrderDomain struct {
+	Total money.Amount
+	Repo  ports.IOrderRepository
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"example.com/shop/internal/core/domain"
+	"example.com/shop/internal/core/services"
+)
+
+type IOrderRepository interface {
+	Create(order *domain.OrderDomain) error
+}
+
+var _ = services.NewOrderService
@@ -0,0 +1,10 @@
+package services
+
+import (
+	"example.com/shop/internal/adapters/database"
+	"example.com/shop/internal/core/ports"
+)
+
+func NewOrderService(repo ports.IOrderRepository) *database.OrderRepository {
+	return nil
+}
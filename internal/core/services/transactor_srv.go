@@ -2,7 +2,6 @@ package services
 
 import (
 	"fmt"
-	"html/template"
 	"os"
 	"path/filepath"
 
@@ -10,6 +9,12 @@ import (
 	"github.com/rapidstellar/gohexa/pkgs/utils"
 )
 
+// transactorTemplate returns the template key, source and data of the transactor file.
+func (g *GeneratorServiceImpls) transactorTemplate() (string, string, any) {
+	key, text := g.ormTemplate("transactor")
+	return key, text, domain.TransactorFlagDomain{ProjectName: g.flag.ProjectName}
+}
+
 // GenerateTransactorFile implements ports.IGeneratorService.
 func (g *GeneratorServiceImpls) GenerateTransactorFile(dir string) {
 	// Define the template for the transactor file
@@ -18,29 +23,23 @@ func (g *GeneratorServiceImpls) GenerateTransactorFile(dir string) {
 	if err != nil {
 		fmt.Printf("Failed to ensure directory: %v", err)
 	}
-	// Create the output file path
-	fileName := "transactor.go"
-	filePath := filepath.Join(dir, fileName)
 
-	// Create the output file
-	file, err := os.Create(filePath)
-	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	// Parse and execute the template
-	tmpl, err := template.New("transactor").Parse(domain.TransactorTemplate)
+	// Render the template
+	_, templateText, data := g.transactorTemplate()
+	content, err := renderTemplate("transactor", templateText, data)
 	if err != nil {
 		fmt.Printf("Error parsing template: %v\n", err)
 		return
 	}
 
-	err = tmpl.Execute(file, nil)
-	if err != nil {
+	// Create the output file path
+	fileName := "transactor.go"
+	filePath := filepath.Join(dir, fileName)
+
+	// Write the output file
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
 		fmt.Printf("Error writing to file: %v\n", err)
-	} else {
-		fmt.Printf("Transactor file '%s' created successfully!\n", filePath)
+		return
 	}
+	fmt.Printf("Transactor file '%s' created successfully!\n", filePath)
 }
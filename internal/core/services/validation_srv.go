@@ -0,0 +1,150 @@
+package services
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+	"github.com/rapidstellar/gohexa/pkgs/utils"
+)
+
+// validates reports whether a field of the feature has a constraint, for which the domain has a
+// Validate method the handlers call.
+func (g *GeneratorServiceImpls) validates() bool {
+	return slices.ContainsFunc(g.fields(), domain.Field.Validated)
+}
+
+// validationTemplate returns the template key, source and data of the validation file. The key is
+// empty when no field has a constraint.
+func (g *GeneratorServiceImpls) validationTemplate() (string, string, any) {
+	if !g.validates() {
+		return "", "", nil
+	}
+	data := domain.ValidationFlagDomain{FeatureName: g.flag.FeatureName}
+	imports := map[string]bool{"errors": true}
+	check := func(failed, message string) {
+		data.Checks = append(data.Checks, domain.ValidationCheck{Failed: failed, Message: strconv.Quote(message)})
+	}
+	match := func(f domain.Field, name, pattern, message string) {
+		if !slices.ContainsFunc(data.Patterns, func(p domain.ValidationPattern) bool { return p.Name == name }) {
+			literal := "`" + pattern + "`"
+			if strings.Contains(pattern, "`") {
+				literal = strconv.Quote(pattern)
+			}
+			data.Patterns = append(data.Patterns, domain.ValidationPattern{Name: name, Pattern: literal})
+		}
+		imports["regexp"] = true
+		check(optional(f)+"!"+name+".MatchString("+fieldValue(f)+")", message)
+	}
+	for _, f := range g.fields() {
+		name, value, present := f.JSONName(), fieldValue(f), ""
+		if f.Nullable {
+			present = "d." + f.Name + " != nil && "
+		}
+		if f.Required {
+			missing := ""
+			if f.Nullable {
+				missing = "d." + f.Name + " == nil || "
+			}
+			if f.Type == "time.Time" {
+				check(missing+"d."+f.Name+".IsZero()", name+" is required")
+			} else {
+				check(missing+value+` == ""`, name+" is required")
+			}
+		}
+		if f.Type == "string" {
+			length := "utf8.RuneCountInString(" + value + ")"
+			// An empty value is missing, which required reports instead, so a minimum of 1 is always met.
+			if min, _ := strconv.Atoi(f.Min); min > 1 {
+				imports["unicode/utf8"] = true
+				check(present+value+` != "" && `+length+" < "+f.Min, fmt.Sprintf("%s must have at least %s", name, characters(f.Min)))
+			}
+			if f.Max != "" {
+				imports["unicode/utf8"] = true
+				check(present+length+" > "+f.Max, fmt.Sprintf("%s must have at most %s", name, characters(f.Max)))
+			}
+		} else {
+			if f.Min != "" {
+				check(present+value+" < "+f.Min, fmt.Sprintf("%s must be at least %s", name, f.Min))
+			}
+			if f.Max != "" {
+				check(present+value+" > "+f.Max, fmt.Sprintf("%s must be at most %s", name, f.Max))
+			}
+		}
+		if f.Enum != "" {
+			values := strings.Split(f.Enum, "|")
+			quoted := make([]string, len(values))
+			for i, v := range values {
+				quoted[i] = strconv.Quote(v)
+			}
+			imports["slices"] = true
+			check(optional(f)+"!slices.Contains([]string{"+strings.Join(quoted, ", ")+"}, "+value+")", fmt.Sprintf("%s must be one of %s", name, strings.Join(values, ", ")))
+		}
+		if f.Format != "" {
+			match(f, f.Format+"Format", domain.ValidationFormats[f.Format], fmt.Sprintf("%s must be a valid %s", name, f.Format))
+		}
+		if f.Pattern != "" {
+			match(f, utils.ToLowerCamel(f.Name)+"Pattern", f.Pattern, fmt.Sprintf("%s must match %s", name, f.Pattern))
+		}
+	}
+	for imp := range imports {
+		data.Imports = append(data.Imports, imp)
+	}
+	slices.Sort(data.Imports)
+	return "validation", domain.ValidationTemplate, data
+}
+
+// characters returns the count n of characters of a length constraint, e.g. 1 character.
+func characters(n string) string {
+	if n == "1" {
+		return n + " character"
+	}
+	return n + " characters"
+}
+
+// fieldValue returns the Go expression of the value of a field of d, dereferenced when it is
+// nullable.
+func fieldValue(f domain.Field) string {
+	if f.Nullable {
+		return "*d." + f.Name
+	}
+	return "d." + f.Name
+}
+
+// optional returns the condition guarding the constraints of a string that is not required, whose
+// empty value is a missing one, or of a nullable field, which is missing when it is nil.
+func optional(f domain.Field) string {
+	guard := ""
+	if f.Nullable {
+		guard = "d." + f.Name + " != nil && "
+	}
+	if f.Required {
+		return guard
+	}
+	return guard + fieldValue(f) + ` != "" && `
+}
+
+// generateValidationFile writes the validation file next to the domain file, when a field has a
+// constraint.
+func (g *GeneratorServiceImpls) generateValidationFile(dir string) {
+	templateKey, templateText, data := g.validationTemplate()
+	if templateKey == "" {
+		return
+	}
+	content, err := renderTemplate(templateKey, templateText, data)
+	if err != nil {
+		fmt.Printf("Error parsing template: %v\n", err)
+		return
+	}
+	filePath := filepath.Join(dir, fmt.Sprintf("%s_validation.go", strings.ToLower(g.flag.FeatureName)))
+	if err := os.WriteFile(filePath, content, 0644); err != nil {
+		fmt.Printf("Error writing to file: %v\n", err)
+		return
+	}
+	fmt.Printf("Validation file '%s' created successfully!\n", filePath)
+	g.recordLayer("validation", templateKey, filePath)
+}
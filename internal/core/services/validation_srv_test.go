@@ -0,0 +1,29 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// TestValidationLength checks that the length of a required string is only checked when it is not
+// empty, which is reported as missing, and that a minimum of 1 needs no check of its own.
+func TestValidationLength(t *testing.T) {
+	g := &GeneratorServiceImpls{flag: domain.GeneratorFlagDomain{FeatureName: "Pet", Fields: []domain.Field{
+		{Name: "Name", Type: "string", Column: "name", Required: true, Min: "1", Max: "64"},
+		{Name: "Code", Type: "string", Column: "code", Required: true, Min: "3"},
+		{Name: "Grade", Type: "string", Column: "grade", Nullable: true, Max: "1"},
+	}}}
+	_, _, data := g.validationTemplate()
+	want := []domain.ValidationCheck{
+		{Failed: `d.Name == ""`, Message: `"name is required"`},
+		{Failed: `utf8.RuneCountInString(d.Name) > 64`, Message: `"name must have at most 64 characters"`},
+		{Failed: `d.Code == ""`, Message: `"code is required"`},
+		{Failed: `d.Code != "" && utf8.RuneCountInString(d.Code) < 3`, Message: `"code must have at least 3 characters"`},
+		{Failed: `d.Grade != nil && utf8.RuneCountInString(*d.Grade) > 1`, Message: `"grade must have at most 1 character"`},
+	}
+	if got := data.(domain.ValidationFlagDomain).Checks; !reflect.DeepEqual(got, want) {
+		t.Errorf("got checks\n%+v\nwant\n%+v", got, want)
+	}
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rapidstellar/gohexa/internal/core/domain"
+)
+
+// verifyFile is a rendered file of the in-memory project that VerifyFeature type-checks.
+type verifyFile struct {
+	name string
+	src  []byte
+}
+
+var (
+	// The standard library is imported from source and cached across verifications.
+	verifyMu      sync.Mutex
+	verifyFset    = token.NewFileSet()
+	stdlibImports types.Importer
+)
+
+// stdlib returns the importer of the standard library. verifyMu must be held.
+func stdlib() types.Importer {
+	if stdlibImports == nil {
+		stdlibImports = importer.ForCompiler(verifyFset, "source", nil)
+	}
+	return stdlibImports
+}
+
+// verifyLayer is a layer of the feature, with the package it is generated into and its file name.
+type verifyLayer struct {
+	pkg, file string
+	render    func() (string, string, any)
+}
+
+// verifyLayers returns the layers of the feature laid out as in the project template.
+func (g *GeneratorServiceImpls) verifyLayers() []verifyLayer {
+	module := "github.com/" + g.flag.ProjectName
+	lower := strings.ToLower(g.flag.FeatureName)
+	useUUID := g.flag.UseUUID
+	layers := []verifyLayer{
+		{module + "/internal/adapters/database", "transactor.go", g.transactorTemplate},
+		{module + "/internal/adapters/database/models", lower + ".go", func() (string, string, any) { return g.modelTemplate(useUUID) }},
+		{module + "/internal/core/domain/" + lower, lower + "_domain.go", func() (string, string, any) { return g.domainTemplate(useUUID) }},
+		{module + "/internal/core/ports/" + lower, lower + "_ports.go", g.portTemplate},
+		{module + "/internal/adapters/repositories/" + lower, lower + "_repository.go", g.repositoryTemplate},
+		{module + "/internal/core/services/" + lower, lower + "_service.go", g.serviceTemplate},
+		{module + "/internal/adapters/http/handlers/" + lower, lower + "_handlers.go", g.handlerTemplate},
+		{module + "/internal/adapters/http/routers", lower + "_routes.go", g.routeTemplate},
+		{module + "/internal/adapters/app", lower + "_app.go", g.appTemplate},
+		{module + "/internal/adapters/grpc/pb/" + lower, lower + ".pb.go", g.grpcPbTemplate},
+		{module + "/internal/adapters/grpc/servers/" + lower, lower + "_server.go", g.grpcServerTemplate},
+		{module + "/internal/adapters/app", lower + "_grpc_app.go", g.grpcAppTemplate},
+		{module + "/internal/adapters/graphql/model", "models_gen.go", g.graphqlModelTemplate},
+		{module + "/internal/adapters/graphql", "resolver.go", g.graphqlResolverBaseTemplate},
+		{module + "/internal/adapters/graphql", lower + ".resolvers.go", g.graphqlResolverTemplate},
+		{module + "/internal/adapters/graphql", lower + "_convert.go", g.graphqlConvertTemplate},
+	}
+	if key, _, _ := g.validationTemplate(); key != "" {
+		layers = append(layers, verifyLayer{module + "/internal/core/domain/" + lower, lower + "_validation.go", g.validationTemplate})
+	}
+	if key, _, _ := g.grpcContainerTemplate(); key != "" {
+		layers = append(layers, verifyLayer{module + "/internal/adapters/app", "grpc_container.go", g.grpcContainerTemplate})
+	}
+	if key, _, _ := g.routerTemplate(); key != "" {
+		layers = append(layers, verifyLayer{module + "/internal/adapters/http/routers", "router.go", g.routerTemplate})
+	}
+	if key, _, _ := g.sqlcGenTemplate(); key != "" {
+		layers = append(layers, verifyLayer{module + "/internal/adapters/database/sqlc", "query.sql.go", g.sqlcGenTemplate})
+	}
+	if key, _, _ := g.entSchemaTemplate(); key != "" {
+		layers = append(layers,
+			verifyLayer{module + "/ent/schema", lower + ".go", g.entSchemaTemplate},
+			verifyLayer{module + "/ent", "client.go", g.entClientTemplate},
+			verifyLayer{module + "/ent/predicate", "predicate.go", g.entPredicateTemplate},
+			verifyLayer{module + "/ent/" + lower, lower + ".go", g.entEntityTemplate},
+		)
+	}
+	if key, _, _ := g.connectPbTemplate(); key != "" {
+		layers = append(layers, verifyLayer{module + "/internal/adapters/grpc/pb/" + lower + "/" + lower + "pbconnect", lower + ".connect.go", g.connectPbTemplate})
+	}
+	return layers
+}
+
+// verifyStubs returns the stubs of the packages the feature depends on, for the selected framework
+// and persistence.
+func (g *GeneratorServiceImpls) verifyStubs() map[string]string {
+	framework := g.flag.HTTP
+	if framework == "" {
+		framework = domain.HTTPFrameworks[0]
+	}
+	stubs := map[string]string{}
+	for _, set := range []map[string]string{domain.VerifyStubs, domain.HTTPVerifyStubs[framework], domain.ORMVerifyStubs[g.persistence()]} {
+		for pkgPath, text := range set {
+			stubs[pkgPath] = text
+		}
+	}
+	return stubs
+}
+
+// VerifyFeature implements ports.IGeneratorService.
+// It renders every layer of the feature in memory and type-checks them against domain.VerifyStubs,
+// without network access or a go.mod.
+func (g *GeneratorServiceImpls) VerifyFeature() ([]domain.VerifyIssue, error) {
+	module := "github.com/" + g.flag.ProjectName
+	pkgs := map[string][]verifyFile{}
+	var generated []string
+	for _, layer := range g.verifyLayers() {
+		key, text, data := layer.render()
+		content, err := renderTemplate(key, text, data)
+		if err != nil {
+			return nil, fmt.Errorf("error rendering %s: %v", key, err)
+		}
+		if _, ok := pkgs[layer.pkg]; !ok {
+			generated = append(generated, layer.pkg)
+		}
+		pkgs[layer.pkg] = append(pkgs[layer.pkg], verifyFile{name: layer.file, src: content})
+	}
+
+	stubData := domain.VerifyFlagDomain{FeatureName: g.flag.FeatureName, ProjectName: g.flag.ProjectName}
+	for pathText, text := range g.verifyStubs() {
+		pkgPath, err := renderTemplate("stub path", pathText, stubData)
+		if err != nil {
+			return nil, err
+		}
+		content, err := renderTemplate("stub", text, stubData)
+		if err != nil {
+			return nil, fmt.Errorf("error rendering stub %s: %v", pkgPath, err)
+		}
+		pkgs[string(pkgPath)] = append(pkgs[string(pkgPath)], verifyFile{name: "gohexa_stub.go", src: content})
+	}
+
+	verifyMu.Lock()
+	defer verifyMu.Unlock()
+	v := &verifier{module: module, pkgs: pkgs, checked: map[string]*types.Package{}}
+	for _, pkgPath := range generated {
+		if _, err := v.Import(pkgPath); err != nil && len(v.issues) == 0 {
+			return nil, err
+		}
+	}
+	sort.SliceStable(v.issues, func(i, j int) bool {
+		if v.issues[i].File != v.issues[j].File {
+			return v.issues[i].File < v.issues[j].File
+		}
+		return v.issues[i].Line < v.issues[j].Line
+	})
+	return v.issues, nil
+}
+
+// verifier type-checks the in-memory packages, importing each of them once.
+type verifier struct {
+	module  string
+	pkgs    map[string][]verifyFile
+	checked map[string]*types.Package
+	issues  []domain.VerifyIssue
+}
+
+// Import implements types.Importer.
+func (v *verifier) Import(pkgPath string) (*types.Package, error) {
+	if pkg, ok := v.checked[pkgPath]; ok {
+		return pkg, nil
+	}
+	files, ok := v.pkgs[pkgPath]
+	if !ok {
+		if strings.Contains(strings.Split(pkgPath, "/")[0], ".") {
+			return nil, fmt.Errorf("package %s is neither generated nor stubbed", pkgPath)
+		}
+		return stdlib().Import(pkgPath)
+	}
+
+	// Files are named by their path in the project so issues point at them.
+	dir := strings.TrimPrefix(strings.TrimPrefix(pkgPath, v.module), "/")
+	var parsed []*ast.File
+	for _, f := range files {
+		name := path.Join(dir, f.name)
+		if f.name == "gohexa_stub.go" {
+			name = pkgPath + " (stub)"
+		}
+		file, err := parser.ParseFile(verifyFset, name, f.src, parser.AllErrors)
+		if err != nil {
+			v.addError(err)
+			continue
+		}
+		parsed = append(parsed, file)
+	}
+	conf := types.Config{
+		Importer: v,
+		Error:    v.addError,
+	}
+	pkg, _ := conf.Check(pkgPath, verifyFset, parsed, nil)
+	v.checked[pkgPath] = pkg
+	return pkg, nil
+}
+
+func (v *verifier) addError(err error) {
+	switch e := err.(type) {
+	case types.Error:
+		pos := e.Fset.Position(e.Pos)
+		v.issues = append(v.issues, domain.VerifyIssue{File: pos.Filename, Line: pos.Line, Column: pos.Column, Message: e.Msg})
+	case scanner.ErrorList:
+		for _, item := range e {
+			v.issues = append(v.issues, domain.VerifyIssue{File: item.Pos.Filename, Line: item.Pos.Line, Column: item.Pos.Column, Message: item.Msg})
+		}
+	default:
+		v.issues = append(v.issues, domain.VerifyIssue{Message: err.Error()})
+	}
+}
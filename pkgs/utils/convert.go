@@ -1,6 +1,9 @@
 package utils
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+)
 
 // ToLower returns the lowercase version of the input string
 func ToLower(s string) string {
@@ -14,3 +17,162 @@ func Pluralize(s string) string {
 	}
 	return s + "s"
 }
+
+// Singularize returns the singular form of a plural English word, e.g. order_items to order_item and
+// categories to category. Irregular plurals are left as they are.
+func Singularize(s string) string {
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(s) > 3:
+		return s[:len(s)-3] + "y"
+	case strings.HasSuffix(lower, "sses"), strings.HasSuffix(lower, "shes"), strings.HasSuffix(lower, "ches"),
+		strings.HasSuffix(lower, "xes"), strings.HasSuffix(lower, "zes"):
+		return s[:len(s)-2]
+	case strings.HasSuffix(lower, "ss"), strings.HasSuffix(lower, "us"), strings.HasSuffix(lower, "is"):
+		return s
+	case strings.HasSuffix(lower, "s"):
+		return s[:len(s)-1]
+	}
+	return s
+}
+
+// initialisms are written in upper case in Go identifiers.
+var initialisms = map[string]bool{"ID": true, "UUID": true, "URL": true, "API": true, "HTTP": true, "IP": true, "JSON": true, "SKU": true}
+
+// ToCamel converts snake_case or camelCase to an exported Go name, e.g. customer_id to CustomerID.
+func ToCamel(s string) string {
+	var b strings.Builder
+	for _, word := range splitWords(s) {
+		if upper := strings.ToUpper(word); initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + strings.ToLower(word[1:]))
+	}
+	return b.String()
+}
+
+// ToSnake converts a Go name or camelCase to snake_case, e.g. CustomerID to customer_id.
+func ToSnake(s string) string {
+	words := splitWords(s)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	return strings.Join(words, "_")
+}
+
+// splitWords splits on underscores, dashes, spaces and case changes, keeping initialisms together.
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == ' ' {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		prev := runes[i-1]
+		nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower)) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// ToProtoGoName returns the Go name protoc-gen-go gives a protobuf field, e.g. customer_id to
+// CustomerId and field_1 to Field_1.
+func ToProtoGoName(s string) string {
+	isLower := func(c byte) bool { return 'a' <= c && c <= 'z' }
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// The underscore is dropped and the next letter upper-cased.
+		case '0' <= c && c <= '9':
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+// ToLowerCamel converts a name to lowerCamelCase as used in GraphQL, e.g. customer_id to customerId
+// and SeaPort to seaPort.
+func ToLowerCamel(s string) string {
+	var b strings.Builder
+	for i, word := range splitWords(s) {
+		if i == 0 {
+			b.WriteString(strings.ToLower(word))
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + strings.ToLower(word[1:]))
+	}
+	return b.String()
+}
+
+// ToSqlcName returns the Go name sqlc gives a table or column, e.g. customer_id to CustomerID and
+// field_1 to Field1. Only id is treated as an initialism, as in sqlc's default configuration.
+func ToSqlcName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		if part == "id" {
+			b.WriteString("ID")
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// entAcronyms are the words ent writes upper case in generated Go names.
+var entAcronyms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "AWS": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GB": true, "GUID": true, "HCL": true, "HTML": true, "HTTP": true, "HTTPS": true,
+	"ID": true, "IP": true, "JSON": true, "KB": true, "LHS": true, "MAC": true, "MB": true,
+	"QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true, "SMTP": true, "SQL": true,
+	"SSH": true, "SSO": true, "TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true,
+	"UID": true, "URI": true, "URL": true, "UTF8": true, "UUID": true, "VM": true, "XML": true,
+	"XMPP": true, "XSRF": true, "XSS": true,
+}
+
+// ToEntName returns the Go name ent generates for a field, e.g. customer_id to CustomerID and
+// field_1 to Field1.
+func ToEntName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		if upper := strings.ToUpper(part); entAcronyms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}